@@ -0,0 +1,220 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// ReadyCondition summarizes the other conditions of an object. It is True
+// once all of them are True.
+const ReadyCondition ConditionType = "Ready"
+
+// DeletingReason is used for all conditions of an object being deleted.
+const DeletingReason = "Deleting"
+
+// DeletionBlockedReason is used when the STACKIT resources of an object
+// being deleted cannot be deleted, because its STACKIT credentials or
+// cluster cannot be resolved. The object keeps its finalizer until they are
+// available again. Removing the finalizer manually deletes the object and
+// leaves its STACKIT resources behind.
+const DeletionBlockedReason = "DeletionBlocked"
+
+// Conditions and reasons of StackitCluster.
+const (
+	// NetworkReadyCondition reports whether the cluster network is available.
+	NetworkReadyCondition ConditionType = "NetworkReady"
+
+	// NetworkProvisioningReason is used while the network is being created.
+	NetworkProvisioningReason = "NetworkProvisioning"
+
+	// NetworkReconciliationFailedReason is used when the network could not be
+	// looked up or created.
+	NetworkReconciliationFailedReason = "NetworkReconciliationFailed"
+
+	// SecurityGroupsReadyCondition reports whether the security groups of the
+	// cluster exist with all their rules.
+	SecurityGroupsReadyCondition ConditionType = "SecurityGroupsReady"
+
+	// SecurityGroupsReconciliationFailedReason is used when the security
+	// groups or their rules could not be created.
+	SecurityGroupsReconciliationFailedReason = "SecurityGroupsReconciliationFailed"
+
+	// LoadBalancerReadyCondition reports whether the API server load balancer
+	// is ready and the control plane endpoint is set.
+	LoadBalancerReadyCondition ConditionType = "LoadBalancerReady"
+
+	// LoadBalancerProvisioningReason is used while the load balancer is being
+	// created.
+	LoadBalancerProvisioningReason = "LoadBalancerProvisioning"
+
+	// LoadBalancerFailedReason is used when STACKIT reports the load balancer
+	// to be in an error state.
+	LoadBalancerFailedReason = "LoadBalancerFailed"
+
+	// LoadBalancerReconciliationFailedReason is used when the load balancer
+	// could not be looked up or created.
+	LoadBalancerReconciliationFailedReason = "LoadBalancerReconciliationFailed"
+
+	// BastionReadyCondition reports whether the bastion host is running and
+	// reachable through its public IP address. It is only set for clusters
+	// with a bastion host and does not affect the readiness of the cluster.
+	BastionReadyCondition ConditionType = "BastionReady"
+
+	// BastionProvisioningReason is used while the bastion host is being
+	// created.
+	BastionProvisioningReason = "BastionProvisioning"
+
+	// BastionReconciliationFailedReason is used when the bastion host, its
+	// security group or public IP could not be created.
+	BastionReconciliationFailedReason = "BastionReconciliationFailed"
+)
+
+// Conditions and reasons of StackitMachine.
+const (
+	// BootstrapDataAvailableCondition reports whether the bootstrap data of
+	// the machine could be read.
+	BootstrapDataAvailableCondition ConditionType = "BootstrapDataAvailable"
+
+	// WaitingForBootstrapDataReason is used while the bootstrap provider has
+	// not created the bootstrap data secret yet.
+	WaitingForBootstrapDataReason = "WaitingForBootstrapData"
+
+	// BootstrapDataUnavailableReason is used when the bootstrap data secret
+	// could not be read.
+	BootstrapDataUnavailableReason = "BootstrapDataUnavailable"
+
+	// BootstrapDataTooLargeReason is used when the bootstrap data exceeds
+	// the size limit of the user data of STACKIT servers.
+	BootstrapDataTooLargeReason = "BootstrapDataTooLarge"
+
+	// BootstrapDataUploadFailedReason is used when the Ignition config could
+	// not be uploaded to object storage.
+	BootstrapDataUploadFailedReason = "BootstrapDataUploadFailed"
+
+	// InstanceReadyCondition reports whether the server of the machine is
+	// running.
+	InstanceReadyCondition ConditionType = "InstanceReady"
+
+	// WaitingForClusterInfrastructureReason is used while the cluster
+	// infrastructure is not ready.
+	WaitingForClusterInfrastructureReason = "WaitingForClusterInfrastructure"
+
+	// InstanceProvisioningReason is used while the server is being created.
+	InstanceProvisioningReason = "InstanceProvisioning"
+
+	// InstanceProvisionFailedReason is used when the server could not be
+	// created or STACKIT reports it to be in an error state.
+	InstanceProvisionFailedReason = "InstanceProvisionFailed"
+
+	// WaitingForVolumesReason is used while the additional volumes of the
+	// machine are being created.
+	WaitingForVolumesReason = "WaitingForVolumes"
+
+	// VolumeProvisionFailedReason is used when an additional volume could
+	// not be created or STACKIT reports it to be in an error state.
+	VolumeProvisionFailedReason = "VolumeProvisionFailed"
+
+	// InstanceNotFoundReason is used when the server of the machine no
+	// longer exists.
+	InstanceNotFoundReason = "InstanceNotFound"
+
+	// InstanceReconciliationFailedReason is used when the server could not be
+	// looked up.
+	InstanceReconciliationFailedReason = "InstanceReconciliationFailed"
+
+	// PublicIPAssociationFailedReason is used when the public IP of the
+	// machine could not be allocated or associated with the server.
+	PublicIPAssociationFailedReason = "PublicIPAssociationFailed"
+
+	// APIServerTargetRegisteredCondition reports whether a control plane
+	// machine is a target of the API server load balancer. It is not set
+	// for worker machines.
+	APIServerTargetRegisteredCondition ConditionType = "APIServerTargetRegistered"
+
+	// APIServerTargetRegistrationFailedReason is used when the machine could
+	// not be added to the API server load balancer.
+	APIServerTargetRegistrationFailedReason = "APIServerTargetRegistrationFailed"
+)
+
+// Conditions and reasons of StackitMachinePool. The bootstrap data conditions
+// and reasons of StackitMachine are used as well.
+const (
+	// InstancesReadyCondition reports whether the pool has the desired number
+	// of servers, all of them running and created from the current template.
+	InstancesReadyCondition ConditionType = "InstancesReady"
+
+	// ScalingUpReason is used while servers are added to the pool.
+	ScalingUpReason = "ScalingUp"
+
+	// ScalingDownReason is used while servers are removed from the pool.
+	ScalingDownReason = "ScalingDown"
+
+	// RollingUpdateInProgressReason is used while servers created from a
+	// previous template are replaced.
+	RollingUpdateInProgressReason = "RollingUpdateInProgress"
+)
+
+// Conditions and reasons of StackitManagedControlPlane.
+const (
+	// SKEClusterReadyCondition reports whether the SKE cluster is healthy.
+	SKEClusterReadyCondition ConditionType = "SKEClusterReady"
+
+	// WaitingForNodePoolsReason is used while the Cluster has no
+	// StackitManagedMachinePools, as SKE clusters need at least one node
+	// pool.
+	WaitingForNodePoolsReason = "WaitingForNodePools"
+
+	// SKEClusterProvisioningReason is used while the SKE cluster is being
+	// created.
+	SKEClusterProvisioningReason = "SKEClusterProvisioning"
+
+	// SKEClusterUpdatingReason is used while SKE applies changes to the
+	// cluster or its node pools.
+	SKEClusterUpdatingReason = "SKEClusterUpdating"
+
+	// SKEClusterUnhealthyReason is used when SKE reports the cluster to be
+	// unhealthy or hibernated.
+	SKEClusterUnhealthyReason = "SKEClusterUnhealthy"
+
+	// SKEClusterReconciliationFailedReason is used when the SKE cluster
+	// could not be looked up, created or updated.
+	SKEClusterReconciliationFailedReason = "SKEClusterReconciliationFailed"
+
+	// KubeconfigAvailableCondition reports whether the kubeconfig Secret of
+	// the cluster holds valid credentials.
+	KubeconfigAvailableCondition ConditionType = "KubeconfigAvailable"
+
+	// KubeconfigReconciliationFailedReason is used when the kubeconfig could
+	// not be requested from SKE or written to the Secret.
+	KubeconfigReconciliationFailedReason = "KubeconfigReconciliationFailed"
+)
+
+// Conditions and reasons of StackitManagedMachinePool.
+const (
+	// NodePoolReadyCondition reports whether the node pool exists in the SKE
+	// cluster and has reached its minimum size.
+	NodePoolReadyCondition ConditionType = "NodePoolReady"
+
+	// WaitingForControlPlaneReason is used while the SKE cluster of the
+	// StackitManagedControlPlane does not exist yet.
+	WaitingForControlPlaneReason = "WaitingForControlPlane"
+
+	// NodePoolProvisioningReason is used while the node pool is being added
+	// to the SKE cluster or its nodes are being created.
+	NodePoolProvisioningReason = "NodePoolProvisioning"
+
+	// NodePoolReconciliationFailedReason is used when the node pool or its
+	// nodes could not be looked up.
+	NodePoolReconciliationFailedReason = "NodePoolReconciliationFailed"
+)
@@ -20,26 +20,479 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
-// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+const (
+	// ClusterFinalizer allows the StackitCluster reconciler to clean up the
+	// STACKIT resources of a cluster before the object is removed.
+	ClusterFinalizer = "stackitcluster.infrastructure.cluster.x-k8s.io"
+
+	// DefaultRegion is the region of StackitClusters not setting one.
+	DefaultRegion = "eu01"
+
+	// DefaultAPIServerPort is the port the Kubernetes API is served on if
+	// the control plane endpoint does not set one.
+	DefaultAPIServerPort = 6443
+)
 
 // StackitClusterSpec defines the desired state of StackitCluster.
 type StackitClusterSpec struct {
-	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// ProjectID is the ID of the STACKIT project the cluster infrastructure
+	// is created in.
+	// +kubebuilder:validation:MinLength=1
+	ProjectID string `json:"projectID"`
+
+	// Region is the STACKIT region the cluster infrastructure is created in.
+	// Defaults to "eu01".
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// Network configures the network the cluster nodes are attached to.
+	// +optional
+	Network NetworkSpec `json:"network,omitempty"`
+
+	// FailureDomains restricts the availability zones of the region
+	// machines are spread across. Defaults to all availability zones.
+	// +optional
+	FailureDomains []string `json:"failureDomains,omitempty"`
+
+	// SecurityGroups configures the security groups of the control plane
+	// and worker machines.
+	// +optional
+	SecurityGroups SecurityGroupsSpec `json:"securityGroups,omitempty"`
+
+	// ControlPlaneEndpoint represents the endpoint used to communicate with the control plane.
+	// +optional
+	ControlPlaneEndpoint APIEndpoint `json:"controlPlaneEndpoint,omitempty"`
+
+	// ControlPlaneLoadBalancer configures the load balancer in front of the
+	// Kubernetes API servers.
+	// +optional
+	ControlPlaneLoadBalancer LoadBalancerSpec `json:"controlPlaneLoadBalancer,omitempty"`
+
+	// IdentityRef references the credentials used to manage the STACKIT
+	// resources of the cluster. If unset, the default credentials of the
+	// controller are used.
+	// +optional
+	IdentityRef *StackitIdentityReference `json:"identityRef,omitempty"`
+
+	// Bastion configures a jump host with a public IP address for SSH
+	// access to the machines of the cluster. Removing it deletes the
+	// bastion host.
+	// +optional
+	Bastion *BastionSpec `json:"bastion,omitempty"`
+
+	// ControlPlaneServerGroup configures the server group the control plane
+	// machines are placed in, so that they run on different hypervisors.
+	// +optional
+	ControlPlaneServerGroup ControlPlaneServerGroupSpec `json:"controlPlaneServerGroup,omitempty"`
+}
+
+// ControlPlaneServerGroupSpec configures the control plane server group of a
+// StackitCluster. Control plane machines referencing a server group in their
+// spec are not placed in it.
+type ControlPlaneServerGroupSpec struct {
+	// Disabled disables the control plane server group. Control plane
+	// machines are then only spread across the failure domains.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Policy is the policy of the server group. Defaults to anti-affinity.
+	// Soft anti-affinity allows more control plane machines than
+	// hypervisors, for example during a rolling update.
+	// +optional
+	Policy ServerGroupPolicy `json:"policy,omitempty"`
+}
+
+// BastionSpec configures the bastion host of a StackitCluster. The bastion
+// host is attached to the cluster network and gets a dedicated security
+// group allowing SSH from AllowedCIDRs. The machines of the cluster allow
+// SSH from the bastion host.
+type BastionSpec struct {
+	// MachineType is the STACKIT machine type (flavor) of the bastion host,
+	// for example "c1.1".
+	// +kubebuilder:validation:MinLength=1
+	MachineType string `json:"machineType"`
+
+	// Image selects the image of the bastion host.
+	Image ImageSpec `json:"image"`
+
+	// SSHKeyName is the name of the STACKIT key pair installed on the
+	// bastion host.
+	// +optional
+	SSHKeyName string `json:"sshKeyName,omitempty"`
+
+	// AvailabilityZone is the availability zone of the bastion host.
+	// Defaults to a zone chosen by STACKIT.
+	// +optional
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// AllowedCIDRs are the IPv4 prefixes SSH connections to the bastion
+	// host are allowed from.
+	// +kubebuilder:validation:MinItems=1
+	AllowedCIDRs []string `json:"allowedCIDRs"`
+}
+
+// StackitIdentityKind is the kind of object holding STACKIT credentials.
+// +kubebuilder:validation:Enum=Secret;StackitClusterIdentity
+type StackitIdentityKind string
+
+const (
+	// SecretIdentityKind references a Secret in the namespace of the
+	// StackitCluster. The Secret holds either a service account key in the
+	// "serviceAccountKey" key, optionally with its private key in
+	// "privateKey", or a static access token in the "token" key.
+	SecretIdentityKind StackitIdentityKind = "Secret"
+
+	// ClusterIdentityKind references a StackitClusterIdentity allowing the
+	// namespace of the StackitCluster.
+	ClusterIdentityKind StackitIdentityKind = "StackitClusterIdentity"
+)
+
+// StackitIdentityReference references the credentials of a cluster.
+type StackitIdentityReference struct {
+	// Kind of the referenced object.
+	Kind StackitIdentityKind `json:"kind"`
+
+	// Name of the referenced object.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// NetworkSpec configures the network of a StackitCluster. Either ID must be set
+// to use an existing network, or CIDR to have a new network created.
+type NetworkSpec struct {
+	// ID is the ID of an existing STACKIT network. When set, no network is
+	// created for the cluster, and the network is never deleted.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Prefix selects the IPv4 prefix of the existing network the machines
+	// of the cluster are in, for networks with several prefixes. Defaults
+	// to the first prefix of the network. Only valid together with ID.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// CIDR is the IPv4 prefix of the network created for the cluster,
+	// for example "10.0.0.0/24". Ignored when ID is set.
+	// +optional
+	CIDR string `json:"cidr,omitempty"`
+
+	// NameServers are the DNS servers configured for the created network.
+	// +optional
+	NameServers []string `json:"nameServers,omitempty"`
+}
+
+// LoadBalancerType describes from where a load balancer is reachable.
+// +kubebuilder:validation:Enum=Public;Internal
+type LoadBalancerType string
+
+const (
+	// PublicLoadBalancer is reachable from the internet through a public IP
+	// address.
+	PublicLoadBalancer LoadBalancerType = "Public"
+
+	// InternalLoadBalancer is only reachable from the cluster network.
+	InternalLoadBalancer LoadBalancerType = "Internal"
+)
+
+// LoadBalancerSpec configures the API server load balancer of a StackitCluster.
+type LoadBalancerSpec struct {
+	// Name is the name of an existing load balancer in front of the API
+	// servers. When set, no load balancer is created for the cluster, and
+	// the load balancer is never changed or deleted except for adding and
+	// removing the control plane machines as targets. The targets are added
+	// to the target pool of the listener on the API server port.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Type selects whether the load balancer gets a public IP address.
+	// Defaults to "Public".
+	// +optional
+	Type LoadBalancerType `json:"type,omitempty"`
+
+	// Port is the port the load balancer and the API servers listen on.
+	// Defaults to the port of the control plane endpoint, or 6443.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// AllowedSourceRanges restricts the CIDRs clients may connect from.
+	// Defaults to allowing all sources.
+	// +optional
+	AllowedSourceRanges []string `json:"allowedSourceRanges,omitempty"`
+
+	// HealthCheck configures the active health check of the API servers.
+	// STACKIT defaults apply to unset fields.
+	// +optional
+	HealthCheck *HealthCheckSpec `json:"healthCheck,omitempty"`
+}
+
+// HealthCheckSpec configures the active health check of load balancer targets.
+type HealthCheckSpec struct {
+	// IntervalSeconds is the time between two health checks of a target.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	IntervalSeconds int32 `json:"intervalSeconds,omitempty"`
+
+	// TimeoutSeconds is the time after which a health check fails.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+
+	// HealthyThreshold is the number of successful health checks after
+	// which a target receives traffic again.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	HealthyThreshold int32 `json:"healthyThreshold,omitempty"`
+
+	// UnhealthyThreshold is the number of failed health checks after which
+	// a target no longer receives traffic.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	UnhealthyThreshold int32 `json:"unhealthyThreshold,omitempty"`
+}
+
+// SecurityGroupsSpec configures the security groups of a StackitCluster.
+// The ingress rules of the managed security groups are reconciled to
+// exactly the rules needed by Kubernetes between the machines of the
+// cluster, the rules of the CNI plugin and the rules configured here. Other
+// ingress rules, for example ones added manually, are removed. Egress
+// rules are not managed.
+type SecurityGroupsSpec struct {
+	// ControlPlaneID is the ID of an existing security group attached to
+	// the control plane machines instead of a created one. Its rules are
+	// not managed, and it is never deleted.
+	// +optional
+	ControlPlaneID string `json:"controlPlaneID,omitempty"`
+
+	// WorkerID is the ID of an existing security group attached to the
+	// worker machines instead of a created one. Its rules are not managed,
+	// and it is never deleted.
+	// +optional
+	WorkerID string `json:"workerID,omitempty"`
 
-	// Foo is an example field of StackitCluster. Edit stackitcluster_types.go to remove/update
-	Foo string `json:"foo,omitempty"`
+	// ControlPlane are additional ingress rules of the control plane
+	// security group, typically for traffic from outside the cluster.
+	// Defaults to allowing the Kubernetes API from everywhere. Ignored when
+	// ControlPlaneID is set.
+	// +optional
+	ControlPlane []SecurityGroupRule `json:"controlPlane,omitempty"`
+
+	// Worker are additional ingress rules of the worker security group,
+	// for example for NodePort services. Ignored when WorkerID is set.
+	// +optional
+	Worker []SecurityGroupRule `json:"worker,omitempty"`
+
+	// CNI selects the CNI plugin whose ports are opened between the
+	// machines of the cluster. Calico is expected to use VXLAN
+	// encapsulation. Defaults to "Calico".
+	// +optional
+	CNI CNIPlugin `json:"cni,omitempty"`
+
+	// AllowAllInClusterTraffic allows all traffic between the machines of
+	// the cluster instead of only the ports needed by Kubernetes and the CNI
+	// plugin, for example for Calico with IP-in-IP encapsulation.
+	// +optional
+	AllowAllInClusterTraffic bool `json:"allowAllInClusterTraffic,omitempty"`
+}
+
+// CNIPlugin is a CNI plugin the security groups of a cluster are prepared for.
+// +kubebuilder:validation:Enum=Calico;Cilium;None
+type CNIPlugin string
+
+const (
+	// CNICalico opens BGP, VXLAN and Typha between the machines.
+	CNICalico CNIPlugin = "Calico"
+
+	// CNICilium opens VXLAN, health checks and Hubble between the machines.
+	CNICilium CNIPlugin = "Cilium"
+
+	// CNINone opens no ports for a CNI plugin. They can be configured as
+	// rules of the security groups.
+	CNINone CNIPlugin = "None"
+)
+
+// SecurityGroupRule allows ingress traffic to the machines of a security group.
+type SecurityGroupRule struct {
+	// Description of the rule.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Protocol of the allowed traffic.
+	// +kubebuilder:validation:Enum=tcp;udp;icmp
+	Protocol string `json:"protocol"`
+
+	// PortRangeMin is the first allowed port. Ignored for ICMP.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	PortRangeMin int32 `json:"portRangeMin,omitempty"`
+
+	// PortRangeMax is the last allowed port. Defaults to PortRangeMin.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	PortRangeMax int32 `json:"portRangeMax,omitempty"`
+
+	// RemoteIPPrefix is the CIDR the traffic is allowed from. Defaults to
+	// "0.0.0.0/0".
+	// +optional
+	RemoteIPPrefix string `json:"remoteIPPrefix,omitempty"`
+}
+
+// DefaultControlPlaneSecurityGroupRules returns the rules of the control plane
+// security group of clusters not configuring any.
+func DefaultControlPlaneSecurityGroupRules(apiServerPort int32) []SecurityGroupRule {
+	return []SecurityGroupRule{{
+		Description:    "Kubernetes API",
+		Protocol:       "tcp",
+		PortRangeMin:   apiServerPort,
+		PortRangeMax:   apiServerPort,
+		RemoteIPPrefix: "0.0.0.0/0",
+	}}
 }
 
 // StackitClusterStatus defines the observed state of StackitCluster.
 type StackitClusterStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// Ready denotes that the cluster infrastructure is ready.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// FailureDomains are the availability zones machines can be placed in.
+	// Metro availability zones, which span several sites, are not used for
+	// control plane machines.
+	// +optional
+	FailureDomains FailureDomains `json:"failureDomains,omitempty"`
+
+	// Network is the network the cluster nodes are attached to.
+	// +optional
+	Network *NetworkStatus `json:"network,omitempty"`
+
+	// SecurityGroups are the security groups of the cluster by role.
+	// +optional
+	SecurityGroups map[SecurityGroupRole]SecurityGroupStatus `json:"securityGroups,omitempty"`
+
+	// APIServerLoadBalancer is the load balancer in front of the Kubernetes
+	// API servers.
+	// +optional
+	APIServerLoadBalancer *LoadBalancerStatus `json:"apiServerLoadBalancer,omitempty"`
+
+	// Bastion is the bastion host of the cluster.
+	// +optional
+	Bastion *BastionStatus `json:"bastion,omitempty"`
+
+	// ControlPlaneServerGroupID is the ID of the server group of the control
+	// plane machines. It is empty if the server group is disabled.
+	// +optional
+	ControlPlaneServerGroupID string `json:"controlPlaneServerGroupID,omitempty"`
+
+	// Conditions defines current service state of the StackitCluster.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+}
+
+// NetworkStatus describes the network of a cluster.
+type NetworkStatus struct {
+	// ID is the ID of the network.
+	ID string `json:"id"`
+
+	// CIDR is the IPv4 prefix of the network.
+	// +optional
+	CIDR string `json:"cidr,omitempty"`
+
+	// NATAddress is the public IP address outgoing traffic of the network
+	// is translated to.
+	// +optional
+	NATAddress string `json:"natAddress,omitempty"`
+
+	// Unmanaged is true for existing networks referenced by the spec,
+	// which are not deleted with the cluster.
+	// +optional
+	Unmanaged bool `json:"unmanaged,omitempty"`
+}
+
+// SecurityGroupRole describes what a security group is used for.
+// +kubebuilder:validation:Enum=control-plane;worker
+type SecurityGroupRole string
+
+const (
+	// SecurityGroupControlPlane is the role of the security group attached
+	// to control plane machines.
+	SecurityGroupControlPlane SecurityGroupRole = "control-plane"
+
+	// SecurityGroupWorker is the role of the security group attached to
+	// worker machines.
+	SecurityGroupWorker SecurityGroupRole = "worker"
+)
+
+// SecurityGroupStatus describes a security group of a cluster.
+type SecurityGroupStatus struct {
+	// ID is the ID of the security group.
+	ID string `json:"id"`
+
+	// Name is the name of the security group.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Unmanaged is true for existing security groups referenced by the
+	// spec, whose rules are not managed and which are not deleted with the
+	// cluster.
+	// +optional
+	Unmanaged bool `json:"unmanaged,omitempty"`
+}
+
+// LoadBalancerStatus describes a load balancer of a cluster.
+type LoadBalancerStatus struct {
+	// Name is the name of the load balancer.
+	Name string `json:"name"`
+
+	// ExternalAddress is the public IP address of the load balancer.
+	// +optional
+	ExternalAddress string `json:"externalAddress,omitempty"`
+
+	// PrivateAddress is the IP address of the load balancer in the cluster
+	// network.
+	// +optional
+	PrivateAddress string `json:"privateAddress,omitempty"`
+
+	// Unmanaged is true for existing load balancers referenced by the spec,
+	// which are not changed or deleted by the cluster.
+	// +optional
+	Unmanaged bool `json:"unmanaged,omitempty"`
+}
+
+// BastionStatus describes the bastion host of a cluster.
+type BastionStatus struct {
+	// ID is the ID of the bastion server.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// State is the state of the bastion server.
+	// +optional
+	State InstanceState `json:"state,omitempty"`
+
+	// Address is the public IP address of the bastion host.
+	// +optional
+	Address string `json:"address,omitempty"`
+
+	// PrivateAddress is the IP address of the bastion host in the cluster
+	// network.
+	// +optional
+	PrivateAddress string `json:"privateAddress,omitempty"`
+
+	// SecurityGroupID is the ID of the security group of the bastion host.
+	// +optional
+	SecurityGroupID string `json:"securityGroupID,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.projectID",description="STACKIT project ID"
+// +kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.region",description="STACKIT region"
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Cluster infrastructure is ready"
+// +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".spec.controlPlaneEndpoint.host",description="API endpoint",priority=1
+// +kubebuilder:printcolumn:name="Bastion",type="string",JSONPath=".status.bastion.address",description="Bastion host address",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // StackitCluster is the Schema for the stackitclusters API.
 type StackitCluster struct {
@@ -59,6 +512,16 @@ type StackitClusterList struct {
 	Items           []StackitCluster `json:"items"`
 }
 
+// GetConditions returns the observations of the operational state of the StackitCluster.
+func (r *StackitCluster) GetConditions() Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the StackitCluster.
+func (r *StackitCluster) SetConditions(conditions Conditions) {
+	r.Status.Conditions = conditions
+}
+
 func init() {
 	SchemeBuilder.Register(&StackitCluster{}, &StackitClusterList{})
 }
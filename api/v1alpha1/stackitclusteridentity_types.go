@@ -0,0 +1,81 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StackitClusterIdentitySpec defines the desired state of StackitClusterIdentity.
+type StackitClusterIdentitySpec struct {
+	// SecretRef references the Secret holding the credentials. The Secret
+	// holds either a service account key in the "serviceAccountKey" key,
+	// optionally with its private key in "privateKey", or a static access
+	// token in the "token" key. Both the name and the namespace of the
+	// Secret must be set, since the identity is cluster-scoped.
+	// +kubebuilder:validation:XValidation:rule="has(self.name) && self.name != ''",message="name must be set"
+	// +kubebuilder:validation:XValidation:rule="has(self.__namespace__) && self.__namespace__ != ''",message="namespace must be set"
+	SecretRef corev1.SecretReference `json:"secretRef"`
+
+	// AllowedNamespaces selects the namespaces of the StackitClusters which
+	// may use the identity. An empty object allows all namespaces, while no
+	// namespace is allowed if it is unset.
+	// +optional
+	AllowedNamespaces *AllowedNamespaces `json:"allowedNamespaces,omitempty"`
+}
+
+// AllowedNamespaces selects namespaces either by name or by label. A
+// namespace is allowed if it matches either of them.
+type AllowedNamespaces struct {
+	// NamespaceList is a list of allowed namespaces.
+	// +optional
+	NamespaceList []string `json:"list,omitempty"`
+
+	// Selector selects the allowed namespaces by their labels. An empty
+	// selector matches no namespace.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".spec.secretRef.name",description="Secret holding the credentials"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// StackitClusterIdentity is the Schema for the stackitclusteridentities API.
+// It provides STACKIT credentials to the StackitClusters of the allowed
+// namespaces.
+type StackitClusterIdentity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec StackitClusterIdentitySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StackitClusterIdentityList contains a list of StackitClusterIdentity.
+type StackitClusterIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StackitClusterIdentity `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&StackitClusterIdentity{}, &StackitClusterIdentityList{})
+}
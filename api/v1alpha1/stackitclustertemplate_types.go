@@ -0,0 +1,63 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StackitClusterTemplateSpec defines the desired state of StackitClusterTemplate.
+type StackitClusterTemplateSpec struct {
+	// Template is the StackitCluster created from the template.
+	Template StackitClusterTemplateResource `json:"template"`
+}
+
+// StackitClusterTemplateResource describes the data needed to create a
+// StackitCluster from a template.
+type StackitClusterTemplateResource struct {
+	// Standard object's metadata.
+	// +optional
+	ObjectMeta ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the specification of the desired behavior of the cluster.
+	Spec StackitClusterSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.template.spec.projectID",description="STACKIT project ID"
+// +kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.template.spec.region",description="STACKIT region"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// StackitClusterTemplate is the Schema for the stackitclustertemplates API.
+type StackitClusterTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec StackitClusterTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StackitClusterTemplateList contains a list of StackitClusterTemplate.
+type StackitClusterTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StackitClusterTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&StackitClusterTemplate{}, &StackitClusterTemplateList{})
+}
@@ -17,29 +17,427 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
-// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+const (
+	// MachineFinalizer allows the StackitMachine reconciler to clean up the
+	// STACKIT resources of a machine before the object is removed.
+	MachineFinalizer = "stackitmachine.infrastructure.cluster.x-k8s.io"
+
+	// DefaultBootVolumeSize is the size in GB of boot volumes not setting one.
+	DefaultBootVolumeSize = 50
+
+	// DefaultPresignedURLExpiry is how long the URL of an Ignition config
+	// uploaded to object storage is valid if the spec does not set it.
+	DefaultPresignedURLExpiry = time.Hour
+)
 
 // StackitMachineSpec defines the desired state of StackitMachine.
 type StackitMachineSpec struct {
-	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// ProviderID is the unique identifier of the server as set by the
+	// cloud provider, in the form "stackit:///<server-id>".
+	// +optional
+	ProviderID *string `json:"providerID,omitempty"`
+
+	// MachineType is the STACKIT machine type (flavor) of the server,
+	// for example "c1.2".
+	// +kubebuilder:validation:MinLength=1
+	MachineType string `json:"machineType"`
+
+	// Image is the image the server boots from.
+	Image ImageSpec `json:"image"`
+
+	// BootVolume configures the volume the server boots from.
+	// +optional
+	BootVolume BootVolumeSpec `json:"bootVolume,omitempty"`
+
+	// AvailabilityZone is the availability zone the server is created in,
+	// for example "eu01-1".
+	// +optional
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// AdditionalVolumes are block volumes created in the availability zone
+	// of the server and attached to it in addition to the boot volume, for
+	// example to store the etcd or container runtime data on volumes with
+	// a dedicated performance class. They are attached in the given order
+	// when the server is created, so the first one is the second disk of
+	// the server, for example /dev/vdb.
+	// +listType=map
+	// +listMapKey=deviceName
+	// +optional
+	AdditionalVolumes []AdditionalVolumeSpec `json:"additionalVolumes,omitempty"`
+
+	// SSHKeyName is the name of the STACKIT key pair installed on the server.
+	// +optional
+	SSHKeyName string `json:"sshKeyName,omitempty"`
+
+	// SecurityGroups are the IDs of additional security groups attached to
+	// the server.
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+
+	// PublicIP attaches a public IP address to the server. An empty object
+	// allocates a public IP, which is released when the machine is deleted.
+	// +optional
+	PublicIP *PublicIPSpec `json:"publicIP,omitempty"`
+
+	// Ignition configures how Ignition bootstrap data, as used by Flatcar
+	// Container Linux, is passed to the server. If set, the Ignition config
+	// is uploaded to an object storage bucket and the server only receives a
+	// small config replacing itself with the uploaded one, so configs larger
+	// than the user data limit can be used. The object is deleted once the
+	// node has joined the cluster. Bootstrap data in other formats is passed
+	// as user data.
+	// +optional
+	Ignition *IgnitionSpec `json:"ignition,omitempty"`
+
+	// ServerGroup places the server in a STACKIT server group, so that the
+	// servers of the group run on different hypervisors. Control plane
+	// machines not setting it are placed in the control plane server group
+	// of the cluster.
+	// +optional
+	ServerGroup *ServerGroupSpec `json:"serverGroup,omitempty"`
+}
+
+// ServerGroupPolicy is the placement policy of a server group.
+// +kubebuilder:validation:Enum=anti-affinity;soft-anti-affinity
+type ServerGroupPolicy string
+
+const (
+	// ServerGroupPolicyAntiAffinity places every server of the group on a
+	// different hypervisor. Creating a server fails if no such hypervisor
+	// is available.
+	ServerGroupPolicyAntiAffinity ServerGroupPolicy = "anti-affinity"
+
+	// ServerGroupPolicySoftAntiAffinity places the servers of the group on
+	// different hypervisors as far as possible.
+	ServerGroupPolicySoftAntiAffinity ServerGroupPolicy = "soft-anti-affinity"
+)
+
+// ControlPlaneServerGroupName is the name of the server group of the control
+// plane machines, which cannot be used as the server group name of a machine.
+const ControlPlaneServerGroupName = "control-plane"
+
+// ServerGroupSpec references an existing server group or one managed for the
+// cluster. Exactly one of ID or Name must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.id) != has(self.name)",message="exactly one of id or name must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.id) || !has(self.policy)",message="policy cannot be set together with id"
+type ServerGroupSpec struct {
+	// ID is the ID of an existing server group. It is not deleted when the
+	// machine is deleted.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Name is the name of a server group shared by the machines of the
+	// cluster setting the same name, for example the machines of one
+	// MachineDeployment. It is named "<namespace>-<cluster name>-<name>" in
+	// STACKIT, created with the first machine and deleted with the last one.
+	// "control-plane" is reserved for the server group of the control plane.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Policy is the policy of the server group created for Name. Defaults
+	// to anti-affinity.
+	// +optional
+	Policy ServerGroupPolicy `json:"policy,omitempty"`
+}
+
+// IgnitionSpec configures the delivery of Ignition configs.
+type IgnitionSpec struct {
+	// ObjectStorage is the bucket the Ignition config is uploaded to.
+	ObjectStorage ObjectStorageSpec `json:"objectStorage"`
+}
+
+// ObjectStorageSpec references a bucket of STACKIT Object Storage or of
+// another S3-compatible object storage.
+type ObjectStorageSpec struct {
+	// Bucket is the name of the bucket. The bucket must exist.
+	// +kubebuilder:validation:MinLength=1
+	Bucket string `json:"bucket"`
+
+	// Endpoint is the URL of the S3 API. Defaults to the STACKIT Object
+	// Storage endpoint of the cluster region,
+	// "https://object.storage.<region>.onstackit.cloud".
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// CredentialsSecret references the Secret in the namespace of the
+	// StackitMachine holding the access key of the bucket in the
+	// "accessKeyID" and "secretAccessKey" keys.
+	CredentialsSecret corev1.LocalObjectReference `json:"credentialsSecret"`
+
+	// PresignedURLExpiry is how long the server can download the Ignition
+	// config. It must cover the time until the server boots and must not
+	// exceed 7 days. Defaults to 1h.
+	// +optional
+	PresignedURLExpiry *metav1.Duration `json:"presignedURLExpiry,omitempty"`
+}
+
+// PublicIPSpec configures the public IP address of a server.
+type PublicIPSpec struct {
+	// ID is the ID of a pre-allocated public IP attached to the server
+	// instead of allocating one. It is kept when the machine is deleted, so
+	// a replacement machine using the same ID gets the same address.
+	// +optional
+	ID string `json:"id,omitempty"`
+}
+
+// AdditionalVolumeSpec configures an additional block volume of a server.
+type AdditionalVolumeSpec struct {
+	// DeviceName identifies the volume within the machine, for example
+	// "etcd". The volume is named "<machine name>-<device name>".
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	DeviceName string `json:"deviceName"`
+
+	// Size is the size of the volume in GB.
+	// +kubebuilder:validation:Minimum=1
+	Size int64 `json:"size"`
+
+	// PerformanceClass is the performance class of the volume, which
+	// determines its IOPS and throughput, for example
+	// "storage_premium_perf6".
+	// +optional
+	PerformanceClass string `json:"performanceClass,omitempty"`
+
+	// Encryption encrypts the volume with a key of the STACKIT Key
+	// Management Service.
+	// +optional
+	Encryption *VolumeEncryptionSpec `json:"encryption,omitempty"`
+
+	// DeleteOnTermination deletes the volume when the machine is deleted.
+	// Otherwise the volume is kept, for example to attach it to another
+	// server. Defaults to true.
+	// +optional
+	DeleteOnTermination *bool `json:"deleteOnTermination,omitempty"`
+}
+
+// VolumeEncryptionSpec references the key encryption key of the STACKIT Key
+// Management Service a volume is encrypted with.
+type VolumeEncryptionSpec struct {
+	// KeyRingID is the ID of the key ring holding the key.
+	// +kubebuilder:validation:MinLength=1
+	KeyRingID string `json:"keyRingID"`
+
+	// KeyID is the ID of the key.
+	// +kubebuilder:validation:MinLength=1
+	KeyID string `json:"keyID"`
+
+	// KeyVersion is the version of the key.
+	// +kubebuilder:validation:Minimum=1
+	KeyVersion int64 `json:"keyVersion"`
+
+	// ServiceAccount is the email of the service account allowed to use the
+	// key.
+	// +kubebuilder:validation:MinLength=1
+	ServiceAccount string `json:"serviceAccount"`
+}
 
-	// Foo is an example field of StackitMachine. Edit stackitmachine_types.go to remove/update
-	Foo string `json:"foo,omitempty"`
+// ImageSpec selects the image of a server. Exactly one of ID, Name or
+// Selector must be set.
+// +kubebuilder:validation:XValidation:rule="[has(self.id), has(self.name), has(self.selector)].filter(x, x).size() == 1",message="exactly one of id, name or selector must be set"
+type ImageSpec struct {
+	// ID is the ID of the image.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Name is the name of the image. It must match exactly one image
+	// available to the project.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Selector selects the newest available image matching all of its
+	// criteria when the server is created. The selected image is recorded
+	// in the status.
+	// +optional
+	Selector *ImageSelector `json:"selector,omitempty"`
+}
+
+// ImageKubernetesVersionLabel is the image label holding the Kubernetes
+// version an image is built for, for example "v1.31.2" or "1.31.2".
+const ImageKubernetesVersionLabel = "kubernetes-version"
+
+// ImageSelector selects an image by its properties instead of its ID, so
+// that new machines pick up new images without changing their template. At
+// least one criterion must be set.
+type ImageSelector struct {
+	// NamePattern is a shell pattern the image name must match, for example
+	// "capi-ubuntu-2204-*". The syntax is the one of Go's path.Match.
+	// +optional
+	NamePattern string `json:"namePattern,omitempty"`
+
+	// MatchLabels are labels the image must have.
+	// +optional
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+
+	// MatchKubernetesVersion restricts the images to the ones whose
+	// "kubernetes-version" label matches the Kubernetes version of the
+	// Machine, so that upgrading the version of a cluster topology also
+	// upgrades the image of the new machines.
+	// +optional
+	MatchKubernetesVersion bool `json:"matchKubernetesVersion,omitempty"`
+
+	// OS restricts the images to an operating system.
+	// +optional
+	OS *ImageOSSelector `json:"os,omitempty"`
+}
+
+// ImageOSSelector matches the operating system of an image.
+type ImageOSSelector struct {
+	// Distro is the operating system distribution, for example "ubuntu" or
+	// "flatcar". It is compared case-insensitively.
+	// +kubebuilder:validation:MinLength=1
+	Distro string `json:"distro"`
+
+	// Version is the version of the distribution, for example "22.04".
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// BootVolumeSpec configures the boot volume of a server.
+type BootVolumeSpec struct {
+	// Size is the size of the boot volume in GB. Defaults to 50.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Size int64 `json:"size,omitempty"`
+
+	// PerformanceClass is the performance class of the boot volume,
+	// for example "storage_premium_perf1".
+	// +optional
+	PerformanceClass string `json:"performanceClass,omitempty"`
+}
+
+// InstanceState describes the state of a STACKIT server.
+type InstanceState string
+
+const (
+	// InstanceStateCreating is the state of a server that is being created.
+	InstanceStateCreating InstanceState = "CREATING"
+	// InstanceStateActive is the state of a running server.
+	InstanceStateActive InstanceState = "ACTIVE"
+	// InstanceStateInactive is the state of a stopped server.
+	InstanceStateInactive InstanceState = "INACTIVE"
+	// InstanceStateDeleting is the state of a server that is being deleted.
+	InstanceStateDeleting InstanceState = "DELETING"
+	// InstanceStateError is the state of a server that failed.
+	InstanceStateError InstanceState = "ERROR"
+)
+
+// MachineAddressType describes a valid MachineAddress type.
+// +kubebuilder:validation:Enum=Hostname;ExternalIP;InternalIP;ExternalDNS;InternalDNS
+type MachineAddressType string
+
+// Define the MachineAddressType constants.
+const (
+	MachineHostName    MachineAddressType = "Hostname"
+	MachineExternalIP  MachineAddressType = "ExternalIP"
+	MachineInternalIP  MachineAddressType = "InternalIP"
+	MachineExternalDNS MachineAddressType = "ExternalDNS"
+	MachineInternalDNS MachineAddressType = "InternalDNS"
+)
+
+// MachineAddress contains information for the node's address. It mirrors
+// the Cluster API MachineAddress type.
+type MachineAddress struct {
+	// Type is the machine address type, one of Hostname, ExternalIP, InternalIP, ExternalDNS or InternalDNS.
+	Type MachineAddressType `json:"type"`
+
+	// Address is the machine address.
+	Address string `json:"address"`
+}
+
+// PublicIPStatus describes the public IP of a server.
+type PublicIPStatus struct {
+	// ID is the ID of the public IP.
+	ID string `json:"id"`
+
+	// Address is the public IP address.
+	// +optional
+	Address string `json:"address,omitempty"`
+
+	// Unmanaged is true for pre-allocated public IPs referenced by the spec,
+	// which are not released when the machine is deleted.
+	// +optional
+	Unmanaged bool `json:"unmanaged,omitempty"`
+}
+
+// VolumeStatus describes an additional volume of a server.
+type VolumeStatus struct {
+	// DeviceName is the device name of the volume in the spec.
+	DeviceName string `json:"deviceName"`
+
+	// ID is the ID of the volume.
+	ID string `json:"id"`
 }
 
 // StackitMachineStatus defines the observed state of StackitMachine.
 type StackitMachineStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// Ready denotes that the server is running and ready to join the cluster.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// Addresses contains the addresses of the server.
+	// +optional
+	Addresses []MachineAddress `json:"addresses,omitempty"`
+
+	// InstanceState is the state of the STACKIT server.
+	// +optional
+	InstanceState *InstanceState `json:"instanceState,omitempty"`
+
+	// PublicIP is the public IP attached to the server.
+	// +optional
+	PublicIP *PublicIPStatus `json:"publicIP,omitempty"`
+
+	// AdditionalVolumes are the additional volumes of the server.
+	// +optional
+	AdditionalVolumes []VolumeStatus `json:"additionalVolumes,omitempty"`
+
+	// IgnitionObjectKey is the key of the Ignition config uploaded to object
+	// storage. It is cleared once the object has been deleted.
+	// +optional
+	IgnitionObjectKey string `json:"ignitionObjectKey,omitempty"`
+
+	// ImageID is the ID of the image the server was created from.
+	// +optional
+	ImageID string `json:"imageID,omitempty"`
+
+	// ServerGroupID is the ID of the server group the server is a member of.
+	// +optional
+	ServerGroupID string `json:"serverGroupID,omitempty"`
+
+	// FailureReason will be set in the event that there is a terminal problem
+	// reconciling the StackitMachine and will contain a succinct value suitable
+	// for machine interpretation.
+	// +optional
+	FailureReason *string `json:"failureReason,omitempty"`
+
+	// FailureMessage will be set in the event that there is a terminal problem
+	// reconciling the StackitMachine and will contain a more verbose string suitable
+	// for logging and human consumption.
+	// +optional
+	FailureMessage *string `json:"failureMessage,omitempty"`
+
+	// Conditions defines current service state of the StackitMachine.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Machine Type",type="string",JSONPath=".spec.machineType",description="STACKIT machine type"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.instanceState",description="STACKIT server state"
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Server is ready"
+// +kubebuilder:printcolumn:name="ProviderID",type="string",JSONPath=".spec.providerID",description="Provider ID",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // StackitMachine is the Schema for the stackitmachines API.
 type StackitMachine struct {
@@ -59,6 +457,16 @@ type StackitMachineList struct {
 	Items           []StackitMachine `json:"items"`
 }
 
+// GetConditions returns the observations of the operational state of the StackitMachine.
+func (r *StackitMachine) GetConditions() Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the StackitMachine.
+func (r *StackitMachine) SetConditions(conditions Conditions) {
+	r.Status.Conditions = conditions
+}
+
 func init() {
 	SchemeBuilder.Register(&StackitMachine{}, &StackitMachineList{})
 }
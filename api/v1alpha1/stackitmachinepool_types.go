@@ -0,0 +1,169 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// MachinePoolFinalizer allows the StackitMachinePool reconciler to delete
+	// the servers of a pool before the object is removed.
+	MachinePoolFinalizer = "stackitmachinepool.infrastructure.cluster.x-k8s.io"
+
+	// DefaultMaxSurge is the number of servers created above the desired
+	// replicas while replacing servers, if the strategy does not set it.
+	DefaultMaxSurge = 1
+)
+
+// StackitMachinePoolSpec defines the desired state of StackitMachinePool.
+type StackitMachinePoolSpec struct {
+	// ProviderIDList are the provider IDs of the servers of the pool, in the
+	// form "stackit:///<server-id>". It is maintained by the controller.
+	// +optional
+	ProviderIDList []string `json:"providerIDList,omitempty"`
+
+	// Template describes the servers of the pool. Servers created from a
+	// previous template, Kubernetes version or bootstrap data secret of the
+	// MachinePool are replaced according to the strategy.
+	Template StackitMachinePoolTemplate `json:"template"`
+
+	// Strategy configures how the servers of the pool are replaced.
+	// +optional
+	Strategy MachinePoolStrategy `json:"strategy,omitempty"`
+}
+
+// StackitMachinePoolTemplate describes the servers of a StackitMachinePool.
+type StackitMachinePoolTemplate struct {
+	// MachineType is the STACKIT machine type (flavor) of the servers,
+	// for example "c1.2".
+	// +kubebuilder:validation:MinLength=1
+	MachineType string `json:"machineType"`
+
+	// Image is the image the servers boot from.
+	Image ImageSpec `json:"image"`
+
+	// BootVolume configures the volume the servers boot from.
+	// +optional
+	BootVolume BootVolumeSpec `json:"bootVolume,omitempty"`
+
+	// AvailabilityZone is the availability zone the servers are created in
+	// if the MachinePool does not list failure domains.
+	// +optional
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// SSHKeyName is the name of the STACKIT key pair installed on the
+	// servers.
+	// +optional
+	SSHKeyName string `json:"sshKeyName,omitempty"`
+
+	// SecurityGroups are the IDs of additional security groups attached to
+	// the servers.
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+}
+
+// MachinePoolStrategy configures the rolling replacement of the servers of a
+// StackitMachinePool. New servers are created before old ones are deleted,
+// within the limits set by MaxSurge and MaxUnavailable.
+type MachinePoolStrategy struct {
+	// MaxSurge is the number of servers that may be created above the
+	// desired replicas. Defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxSurge *int32 `json:"maxSurge,omitempty"`
+
+	// MaxUnavailable is the number of servers below the desired replicas
+	// that may be unavailable. Defaults to 0.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+}
+
+// StackitMachinePoolStatus defines the observed state of StackitMachinePool.
+type StackitMachinePoolStatus struct {
+	// Ready is true once the desired number of servers has been running. It
+	// is not reset while servers are replaced or the pool is scaled.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// Replicas is the number of servers of the pool.
+	// +optional
+	Replicas int32 `json:"replicas"`
+
+	// ReadyReplicas is the number of running servers of the pool.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// UpToDateReplicas is the number of servers created from the current
+	// template.
+	// +optional
+	UpToDateReplicas int32 `json:"upToDateReplicas,omitempty"`
+
+	// TemplateHash identifies the current template. The servers of the
+	// pool are labeled with the hash of the template they were created from.
+	// +optional
+	TemplateHash string `json:"templateHash,omitempty"`
+
+	// ImageID is the ID of the image servers were last created from.
+	// +optional
+	ImageID string `json:"imageID,omitempty"`
+
+	// Conditions defines current service state of the StackitMachinePool.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Machine Type",type="string",JSONPath=".spec.template.machineType",description="STACKIT machine type"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas",description="Number of servers"
+// +kubebuilder:printcolumn:name="Ready Replicas",type="integer",JSONPath=".status.readyReplicas",description="Number of running servers"
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Servers are ready"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// StackitMachinePool is the Schema for the stackitmachinepools API.
+type StackitMachinePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StackitMachinePoolSpec   `json:"spec,omitempty"`
+	Status StackitMachinePoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StackitMachinePoolList contains a list of StackitMachinePool.
+type StackitMachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StackitMachinePool `json:"items"`
+}
+
+// GetConditions returns the observations of the operational state of the StackitMachinePool.
+func (r *StackitMachinePool) GetConditions() Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the StackitMachinePool.
+func (r *StackitMachinePool) SetConditions(conditions Conditions) {
+	r.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&StackitMachinePool{}, &StackitMachinePoolList{})
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StackitMachineTemplateSpec defines the desired state of StackitMachineTemplate.
+type StackitMachineTemplateSpec struct {
+	// Template is the StackitMachine created from the template.
+	Template StackitMachineTemplateResource `json:"template"`
+}
+
+// StackitMachineTemplateResource describes the data needed to create a
+// StackitMachine from a template.
+type StackitMachineTemplateResource struct {
+	// Standard object's metadata.
+	// +optional
+	ObjectMeta ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the specification of the desired behavior of the machine.
+	Spec StackitMachineSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Machine Type",type="string",JSONPath=".spec.template.spec.machineType",description="STACKIT machine type"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// StackitMachineTemplate is the Schema for the stackitmachinetemplates API.
+type StackitMachineTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec StackitMachineTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StackitMachineTemplateList contains a list of StackitMachineTemplate.
+type StackitMachineTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StackitMachineTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&StackitMachineTemplate{}, &StackitMachineTemplateList{})
+}
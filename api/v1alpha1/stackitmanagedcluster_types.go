@@ -0,0 +1,68 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StackitManagedClusterSpec defines the desired state of StackitManagedCluster.
+type StackitManagedClusterSpec struct {
+	// ControlPlaneEndpoint is the endpoint of the Kubernetes API. It is
+	// copied from the StackitManagedControlPlane of the Cluster.
+	// +optional
+	ControlPlaneEndpoint APIEndpoint `json:"controlPlaneEndpoint,omitempty"`
+}
+
+// StackitManagedClusterStatus defines the observed state of StackitManagedCluster.
+type StackitManagedClusterStatus struct {
+	// Ready is always true, as the infrastructure of SKE clusters is
+	// managed by the StackitManagedControlPlane.
+	// +optional
+	Ready bool `json:"ready"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".metadata.labels['cluster\\.x-k8s\\.io/cluster-name']",description="Cluster"
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Cluster infrastructure is ready"
+// +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".spec.controlPlaneEndpoint.host",description="API endpoint"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// StackitManagedCluster is the Schema for the stackitmanagedclusters API. It is
+// the infrastructure of Clusters whose control plane is a
+// StackitManagedControlPlane.
+type StackitManagedCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StackitManagedClusterSpec   `json:"spec,omitempty"`
+	Status StackitManagedClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StackitManagedClusterList contains a list of StackitManagedCluster.
+type StackitManagedClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StackitManagedCluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&StackitManagedCluster{}, &StackitManagedClusterList{})
+}
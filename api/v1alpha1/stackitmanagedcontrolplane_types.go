@@ -0,0 +1,185 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ManagedControlPlaneFinalizer allows the StackitManagedControlPlane
+	// reconciler to delete the SKE cluster before the object is removed.
+	ManagedControlPlaneFinalizer = "stackitmanagedcontrolplane.infrastructure.cluster.x-k8s.io"
+
+	// KubeconfigExpiresAtAnnotation is set on the kubeconfig Secret of a
+	// StackitManagedControlPlane to the time the credentials in it expire,
+	// in RFC 3339 format. The kubeconfig is renewed before it expires.
+	KubeconfigExpiresAtAnnotation = "infrastructure.cluster.x-k8s.io/kubeconfig-expires-at"
+
+	// MaxSKEClusterNameLength is the maximum length of the name of an SKE
+	// cluster.
+	MaxSKEClusterNameLength = 11
+)
+
+// StackitManagedControlPlaneSpec defines the desired state of
+// StackitManagedControlPlane.
+type StackitManagedControlPlaneSpec struct {
+	// ProjectID is the ID of the STACKIT project the SKE cluster is created
+	// in.
+	// +kubebuilder:validation:MinLength=1
+	ProjectID string `json:"projectID"`
+
+	// Region is the STACKIT region the SKE cluster is created in. Defaults
+	// to "eu01".
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// IdentityRef references the credentials used to manage the SKE
+	// cluster. If unset, the default credentials of the controller are
+	// used.
+	// +optional
+	IdentityRef *StackitIdentityReference `json:"identityRef,omitempty"`
+
+	// Name is the name of the SKE cluster, which must be unique within the
+	// project. Defaults to the name of the StackitManagedControlPlane if
+	// that is a valid SKE cluster name.
+	// +kubebuilder:validation:MaxLength=11
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Version is the Kubernetes version of the SKE cluster, for example
+	// "v1.31.4". Versions older than the one of the cluster are ignored,
+	// so that updates applied by SKE during maintenance are not reverted.
+	// +kubebuilder:validation:MinLength=1
+	Version string `json:"version"`
+
+	// NetworkID is the ID of an existing STACKIT network the nodes are
+	// attached to. Defaults to a network managed by SKE.
+	// +optional
+	NetworkID string `json:"networkID,omitempty"`
+
+	// Maintenance configures when and what SKE updates automatically.
+	// Defaults to the SKE defaults.
+	// +optional
+	Maintenance *ManagedMaintenanceSpec `json:"maintenance,omitempty"`
+
+	// ControlPlaneEndpoint is the endpoint of the Kubernetes API of the SKE
+	// cluster. It is set by the controller.
+	// +optional
+	ControlPlaneEndpoint APIEndpoint `json:"controlPlaneEndpoint,omitempty"`
+}
+
+// ManagedMaintenanceSpec configures the maintenance of an SKE cluster.
+type ManagedMaintenanceSpec struct {
+	// AutoUpdateKubernetesVersion allows SKE to update the Kubernetes patch
+	// version during maintenance.
+	// +optional
+	AutoUpdateKubernetesVersion bool `json:"autoUpdateKubernetesVersion,omitempty"`
+
+	// AutoUpdateMachineImageVersion allows SKE to update the machine image
+	// version of the node pools during maintenance.
+	// +optional
+	AutoUpdateMachineImageVersion bool `json:"autoUpdateMachineImageVersion,omitempty"`
+
+	// Start is the time of day the daily maintenance window starts, for
+	// example "03:00:00+02:00".
+	// +kubebuilder:validation:MinLength=1
+	Start string `json:"start"`
+
+	// End is the time of day the daily maintenance window ends.
+	// +kubebuilder:validation:MinLength=1
+	End string `json:"end"`
+}
+
+// StackitManagedControlPlaneStatus defines the observed state of
+// StackitManagedControlPlane.
+type StackitManagedControlPlaneStatus struct {
+	// Ready denotes that the SKE cluster is healthy. It stays true while
+	// SKE updates the cluster.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// Initialized denotes that the kubeconfig Secret of the cluster has
+	// been written and the Kubernetes API can be used.
+	// +optional
+	Initialized bool `json:"initialized"`
+
+	// ExternalManagedControlPlane tells Cluster API that the control plane
+	// has no Machines. It is always true.
+	// +optional
+	ExternalManagedControlPlane bool `json:"externalManagedControlPlane"`
+
+	// Version is the Kubernetes version of the SKE cluster.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// State is the aggregated state of the SKE cluster reported by SKE, for
+	// example "STATE_HEALTHY".
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// Conditions defines current service state of the StackitManagedControlPlane.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".metadata.labels['cluster\\.x-k8s\\.io/cluster-name']",description="Cluster"
+// +kubebuilder:printcolumn:name="SKE Cluster",type="string",JSONPath=".spec.name",description="SKE cluster name"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.version",description="Kubernetes version"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state",description="SKE cluster state"
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="SKE cluster is ready"
+// +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".spec.controlPlaneEndpoint.host",description="API endpoint",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// StackitManagedControlPlane is the Schema for the stackitmanagedcontrolplanes
+// API. It manages a STACKIT Kubernetes Engine (SKE) cluster, whose node
+// pools are described by the StackitManagedMachinePools of the MachinePools
+// of the Cluster. The kubeconfig of the SKE cluster is written to the
+// "<cluster>-kubeconfig" Secret.
+type StackitManagedControlPlane struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StackitManagedControlPlaneSpec   `json:"spec,omitempty"`
+	Status StackitManagedControlPlaneStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StackitManagedControlPlaneList contains a list of StackitManagedControlPlane.
+type StackitManagedControlPlaneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StackitManagedControlPlane `json:"items"`
+}
+
+// GetConditions returns the observations of the operational state of the StackitManagedControlPlane.
+func (r *StackitManagedControlPlane) GetConditions() Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the StackitManagedControlPlane.
+func (r *StackitManagedControlPlane) SetConditions(conditions Conditions) {
+	r.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&StackitManagedControlPlane{}, &StackitManagedControlPlaneList{})
+}
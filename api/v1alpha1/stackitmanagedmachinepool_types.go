@@ -0,0 +1,204 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ManagedMachinePoolFinalizer allows the StackitManagedMachinePool
+	// reconciler to wait until the node pool is removed from the SKE cluster
+	// before the object is removed.
+	ManagedMachinePoolFinalizer = "stackitmanagedmachinepool.infrastructure.cluster.x-k8s.io"
+
+	// NodePoolLabel is the label SKE sets on the nodes of a node pool, with
+	// the name of the node pool as value.
+	NodePoolLabel = "worker.gardener.cloud/pool"
+
+	// MaxSKENodePoolNameLength is the maximum length of the name of an SKE
+	// node pool.
+	MaxSKENodePoolNameLength = 15
+
+	// DefaultManagedMachineImage is the machine image of node pools not
+	// setting one.
+	DefaultManagedMachineImage = "flatcar"
+)
+
+// StackitManagedMachinePoolSpec defines the desired state of
+// StackitManagedMachinePool.
+type StackitManagedMachinePoolSpec struct {
+	// Name is the name of the node pool in the SKE cluster. Defaults to the
+	// name of the StackitManagedMachinePool if that is a valid node pool
+	// name.
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// MachineType is the STACKIT machine type (flavor) of the nodes, for
+	// example "c1.2".
+	// +kubebuilder:validation:MinLength=1
+	MachineType string `json:"machineType"`
+
+	// Image is the machine image of the nodes.
+	Image ManagedMachineImage `json:"image"`
+
+	// BootVolume configures the root volume of the nodes.
+	// +optional
+	BootVolume BootVolumeSpec `json:"bootVolume,omitempty"`
+
+	// AvailabilityZones are the availability zones the nodes are spread
+	// across. Defaults to the failure domains of the MachinePool, or all
+	// availability zones of the region.
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// Scaling lets SKE scale the node pool between a minimum and maximum
+	// size, ignoring the replicas of the MachinePool. The MachinePool should
+	// then have the "cluster.x-k8s.io/replicas-managed-by" annotation. If
+	// unset, the node pool has exactly the replicas of the MachinePool.
+	// +optional
+	Scaling *ManagedMachinePoolScaling `json:"scaling,omitempty"`
+
+	// MaxSurge is the number of nodes created above the size of the pool
+	// during updates. Defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxSurge *int32 `json:"maxSurge,omitempty"`
+
+	// MaxUnavailable is the number of nodes that may be unavailable during
+	// updates. Defaults to 0.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+
+	// Labels are set on the nodes of the pool.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Taints are set on the nodes of the pool.
+	// +optional
+	Taints []Taint `json:"taints,omitempty"`
+
+	// ProviderIDList are the provider IDs of the nodes of the pool. It is
+	// maintained by the controller.
+	// +optional
+	ProviderIDList []string `json:"providerIDList,omitempty"`
+}
+
+// ManagedMachineImage selects the machine image of an SKE node pool.
+type ManagedMachineImage struct {
+	// Name is the name of the machine image, for example "flatcar" or
+	// "ubuntu". Defaults to "flatcar".
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Version is the version of the machine image, for example "3975.2.0".
+	// +kubebuilder:validation:MinLength=1
+	Version string `json:"version"`
+}
+
+// ManagedMachinePoolScaling configures the autoscaling of an SKE node pool.
+type ManagedMachinePoolScaling struct {
+	// MinSize is the minimum number of nodes of the pool.
+	// +kubebuilder:validation:Minimum=0
+	MinSize int32 `json:"minSize"`
+
+	// MaxSize is the maximum number of nodes of the pool.
+	// +kubebuilder:validation:Minimum=1
+	MaxSize int32 `json:"maxSize"`
+}
+
+// TaintEffect is the effect of a taint on pods not tolerating it.
+// +kubebuilder:validation:Enum=NoSchedule;PreferNoSchedule;NoExecute
+type TaintEffect string
+
+// Taint is a taint of the nodes of an SKE node pool.
+type Taint struct {
+	// Key of the taint.
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// Value of the taint.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Effect of the taint.
+	Effect TaintEffect `json:"effect"`
+}
+
+// StackitManagedMachinePoolStatus defines the observed state of
+// StackitManagedMachinePool.
+type StackitManagedMachinePoolStatus struct {
+	// Ready denotes that the node pool exists in the SKE cluster and has
+	// reached its minimum size. It stays true once set.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// Replicas is the number of nodes of the pool.
+	// +optional
+	Replicas int32 `json:"replicas"`
+
+	// Conditions defines current service state of the StackitManagedMachinePool.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Node Pool",type="string",JSONPath=".spec.name",description="SKE node pool name"
+// +kubebuilder:printcolumn:name="Machine Type",type="string",JSONPath=".spec.machineType",description="STACKIT machine type"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas",description="Number of nodes"
+// +kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Node pool is ready"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// StackitManagedMachinePool is the Schema for the stackitmanagedmachinepools
+// API. It describes a node pool of the SKE cluster of a
+// StackitManagedControlPlane. The node pool itself is managed by the
+// StackitManagedControlPlane, as SKE updates all node pools of a cluster
+// together.
+type StackitManagedMachinePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StackitManagedMachinePoolSpec   `json:"spec,omitempty"`
+	Status StackitManagedMachinePoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StackitManagedMachinePoolList contains a list of StackitManagedMachinePool.
+type StackitManagedMachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StackitManagedMachinePool `json:"items"`
+}
+
+// GetConditions returns the observations of the operational state of the StackitManagedMachinePool.
+func (r *StackitManagedMachinePool) GetConditions() Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the StackitManagedMachinePool.
+func (r *StackitManagedMachinePool) SetConditions(conditions Conditions) {
+	r.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&StackitManagedMachinePool{}, &StackitManagedMachinePoolList{})
+}
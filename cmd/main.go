@@ -38,7 +38,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	clusterv1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/capi/v1beta1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud/s3"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud/stackit"
 	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/controller"
+	webhookv1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/webhook/v1alpha1"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -51,6 +55,7 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(infrastructurev1alpha1.AddToScheme(scheme))
+	utilruntime.Must(clusterv1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -63,6 +68,8 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var stackitIaaSEndpoint, stackitLoadBalancerEndpoint, stackitSKEEndpoint string
+	var stackitTokenEndpoint, stackitCredentialsFile, stackitPrivateKeyFile string
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -81,6 +88,20 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&stackitIaaSEndpoint, "stackit-iaas-endpoint", stackit.DefaultIaaSEndpoint,
+		"The base URL of the STACKIT IaaS API.")
+	flag.StringVar(&stackitLoadBalancerEndpoint, "stackit-load-balancer-endpoint", stackit.DefaultLoadBalancerEndpoint,
+		"The base URL of the STACKIT Load Balancer API.")
+	flag.StringVar(&stackitSKEEndpoint, "stackit-ske-endpoint", stackit.DefaultSKEEndpoint,
+		"The base URL of the STACKIT Kubernetes Engine API.")
+	flag.StringVar(&stackitTokenEndpoint, "stackit-token-endpoint", stackit.DefaultTokenEndpoint,
+		"The URL access tokens are requested from with STACKIT service account keys.")
+	flag.StringVar(&stackitCredentialsFile, "stackit-credentials-file", "",
+		"The file holding the default STACKIT credentials, either a service account key or an access token. "+
+			"Used for clusters without an identityRef and re-read on every reconcile.")
+	flag.StringVar(&stackitPrivateKeyFile, "stackit-private-key-file", "",
+		"The file holding the private key of the service account key in --stackit-credentials-file, "+
+			"if the key does not contain it.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -202,20 +223,94 @@ func main() {
 		os.Exit(1)
 	}
 
+	cloudFactory := stackit.NewFactory(stackit.Options{
+		IaaSEndpoint:         stackitIaaSEndpoint,
+		LoadBalancerEndpoint: stackitLoadBalancerEndpoint,
+		SKEEndpoint:          stackitSKEEndpoint,
+		TokenEndpoint:        stackitTokenEndpoint,
+		CredentialsFile:      stackitCredentialsFile,
+		PrivateKeyFile:       stackitPrivateKeyFile,
+	})
+
 	if err := (&controller.StackitClusterReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		CloudFactory: cloudFactory,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "StackitCluster")
 		os.Exit(1)
 	}
 	if err := (&controller.StackitMachineReconciler{
+		Client:               mgr.GetClient(),
+		Scheme:               mgr.GetScheme(),
+		CloudFactory:         cloudFactory,
+		ObjectStorageFactory: s3.NewFactory(s3.Options{}),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "StackitMachine")
+		os.Exit(1)
+	}
+	if err := (&controller.StackitMachinePoolReconciler{
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		CloudFactory: cloudFactory,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "StackitMachinePool")
+		os.Exit(1)
+	}
+	if err := (&controller.StackitManagedControlPlaneReconciler{
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		CloudFactory: cloudFactory,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "StackitManagedControlPlane")
+		os.Exit(1)
+	}
+	if err := (&controller.StackitManagedClusterReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "StackitMachine")
+		setupLog.Error(err, "unable to create controller", "controller", "StackitManagedCluster")
 		os.Exit(1)
 	}
+	if err := (&controller.StackitManagedMachinePoolReconciler{
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		CloudFactory: cloudFactory,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "StackitManagedMachinePool")
+		os.Exit(1)
+	}
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err := webhookv1alpha1.SetupStackitClusterWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "StackitCluster")
+			os.Exit(1)
+		}
+		if err := webhookv1alpha1.SetupStackitMachineWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "StackitMachine")
+			os.Exit(1)
+		}
+		if err := webhookv1alpha1.SetupStackitMachineTemplateWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "StackitMachineTemplate")
+			os.Exit(1)
+		}
+		if err := webhookv1alpha1.SetupStackitClusterTemplateWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "StackitClusterTemplate")
+			os.Exit(1)
+		}
+		if err := webhookv1alpha1.SetupStackitMachinePoolWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "StackitMachinePool")
+			os.Exit(1)
+		}
+		if err := webhookv1alpha1.SetupStackitManagedControlPlaneWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "StackitManagedControlPlane")
+			os.Exit(1)
+		}
+		if err := webhookv1alpha1.SetupStackitManagedMachinePoolWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "StackitManagedMachinePool")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if metricsCertWatcher != nil {
@@ -0,0 +1,185 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ClusterNameLabel is the label set on objects belonging to a cluster.
+	ClusterNameLabel = "cluster.x-k8s.io/cluster-name"
+
+	// MachineControlPlaneLabel is the label set on machines that are part of
+	// the control plane.
+	MachineControlPlaneLabel = "cluster.x-k8s.io/control-plane"
+
+	// PausedAnnotation is an annotation that can be applied to any Cluster API
+	// object to prevent a controller from processing a resource.
+	PausedAnnotation = "cluster.x-k8s.io/paused"
+
+	// ClusterSecretType is the type of the Secrets Cluster API reads, for
+	// example the kubeconfig of a cluster.
+	ClusterSecretType corev1.SecretType = "cluster.x-k8s.io/secret"
+)
+
+// MachineStatusError defines errors states for Machine objects.
+type MachineStatusError string
+
+const (
+	// CreateMachineError indicates an error while trying to create a Node
+	// to match this Machine.
+	CreateMachineError MachineStatusError = "CreateError"
+
+	// UpdateMachineError indicates an error while trying to update a Node
+	// that this Machine represents.
+	UpdateMachineError MachineStatusError = "UpdateError"
+
+	// DeleteMachineError indicates an error was encountered while trying to
+	// delete the Node that this Machine represents.
+	DeleteMachineError MachineStatusError = "DeleteError"
+)
+
+// APIEndpoint represents a reachable Kubernetes API endpoint.
+type APIEndpoint struct {
+	Host string `json:"host,omitempty"`
+	Port int32  `json:"port,omitempty"`
+}
+
+// ClusterSpec defines the desired state of Cluster.
+type ClusterSpec struct {
+	Paused               bool                    `json:"paused,omitempty"`
+	ControlPlaneEndpoint APIEndpoint             `json:"controlPlaneEndpoint,omitempty"`
+	ControlPlaneRef      *corev1.ObjectReference `json:"controlPlaneRef,omitempty"`
+	InfrastructureRef    *corev1.ObjectReference `json:"infrastructureRef,omitempty"`
+}
+
+// ClusterStatus defines the observed state of Cluster.
+type ClusterStatus struct {
+	Phase               string `json:"phase,omitempty"`
+	InfrastructureReady bool   `json:"infrastructureReady"`
+	ControlPlaneReady   bool   `json:"controlPlaneReady"`
+}
+
+// +kubebuilder:object:root=true
+
+// Cluster is the Schema for the clusters API.
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterSpec   `json:"spec,omitempty"`
+	Status ClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterList contains a list of Cluster.
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Cluster `json:"items"`
+}
+
+// Bootstrap encapsulates fields to configure the Machine’s bootstrapping mechanism.
+type Bootstrap struct {
+	ConfigRef      *corev1.ObjectReference `json:"configRef,omitempty"`
+	DataSecretName *string                 `json:"dataSecretName,omitempty"`
+}
+
+// MachineSpec defines the desired state of Machine.
+type MachineSpec struct {
+	ClusterName       string                 `json:"clusterName"`
+	Bootstrap         Bootstrap              `json:"bootstrap"`
+	InfrastructureRef corev1.ObjectReference `json:"infrastructureRef"`
+	Version           *string                `json:"version,omitempty"`
+	ProviderID        *string                `json:"providerID,omitempty"`
+	FailureDomain     *string                `json:"failureDomain,omitempty"`
+}
+
+// MachineStatus defines the observed state of Machine.
+type MachineStatus struct {
+	NodeRef             *corev1.ObjectReference `json:"nodeRef,omitempty"`
+	Phase               string                  `json:"phase,omitempty"`
+	BootstrapReady      bool                    `json:"bootstrapReady"`
+	InfrastructureReady bool                    `json:"infrastructureReady"`
+}
+
+// +kubebuilder:object:root=true
+
+// Machine is the Schema for the machines API.
+type Machine struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MachineSpec   `json:"spec,omitempty"`
+	Status MachineStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MachineList contains a list of Machine.
+type MachineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Machine `json:"items"`
+}
+
+// MachineTemplateSpec describes the Machines created from a template.
+type MachineTemplateSpec struct {
+	Spec MachineSpec `json:"spec,omitempty"`
+}
+
+// MachinePoolSpec defines the desired state of MachinePool.
+type MachinePoolSpec struct {
+	ClusterName    string              `json:"clusterName"`
+	Replicas       *int32              `json:"replicas,omitempty"`
+	Template       MachineTemplateSpec `json:"template"`
+	FailureDomains []string            `json:"failureDomains,omitempty"`
+}
+
+// MachinePoolStatus defines the observed state of MachinePool.
+type MachinePoolStatus struct {
+	Phase               string `json:"phase,omitempty"`
+	BootstrapReady      bool   `json:"bootstrapReady"`
+	InfrastructureReady bool   `json:"infrastructureReady"`
+}
+
+// +kubebuilder:object:root=true
+
+// MachinePool is the Schema for the machinepools API.
+type MachinePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MachinePoolSpec   `json:"spec,omitempty"`
+	Status MachinePoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MachinePoolList contains a list of MachinePool.
+type MachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MachinePool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Cluster{}, &ClusterList{}, &Machine{}, &MachineList{}, &MachinePool{}, &MachinePoolList{})
+}
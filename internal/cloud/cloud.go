@@ -0,0 +1,264 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cloud defines the STACKIT services used by the reconcilers.
+//
+// The reconcilers only depend on the interfaces in this package. The
+// implementation talking to the STACKIT APIs lives in the stackit
+// sub-package, an in-memory implementation for tests in the fake
+// sub-package.
+package cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// ClusterUIDLabel is the label set on every STACKIT resource created for
+	// a cluster. Its value is the UID of the owning StackitCluster.
+	ClusterUIDLabel = "capst-cluster-uid"
+
+	// MachineUIDLabel is the label set on every STACKIT resource created for
+	// a machine. Its value is the UID of the owning StackitMachine.
+	MachineUIDLabel = "capst-machine-uid"
+
+	// MachinePoolUIDLabel is the label set on the servers of a machine pool.
+	// Its value is the UID of the owning StackitMachinePool.
+	MachinePoolUIDLabel = "capst-machine-pool-uid"
+
+	// TemplateHashLabel is the label set on the servers of a machine pool.
+	// Its value identifies the template the server was created from.
+	TemplateHashLabel = "capst-template-hash"
+
+	// RoleLabel is the label describing what a STACKIT resource is used for
+	// within a cluster, for example "control-plane".
+	RoleLabel = "capst-role"
+
+	// ProviderIDPrefix is the prefix of the provider IDs of STACKIT servers.
+	ProviderIDPrefix = "stackit:///"
+)
+
+// ProviderID returns the provider ID of the server with the given ID.
+func ProviderID(serverID string) string {
+	return ProviderIDPrefix + serverID
+}
+
+// ServerIDFromProviderID returns the server ID contained in providerID.
+func ServerIDFromProviderID(providerID string) (string, error) {
+	id, ok := strings.CutPrefix(providerID, ProviderIDPrefix)
+	if !ok || id == "" || strings.Contains(id, "/") {
+		return "", fmt.Errorf("invalid provider ID %q, expected %s<server-id>", providerID, ProviderIDPrefix)
+	}
+	return id, nil
+}
+
+// Scope identifies the STACKIT project and region an Interface operates on.
+type Scope struct {
+	// ProjectID is the ID of the STACKIT project.
+	ProjectID string
+
+	// Region is the STACKIT region, for example "eu01".
+	Region string
+
+	// Credentials authenticate the requests. If nil, the default
+	// credentials of the Factory are used.
+	Credentials *Credentials
+}
+
+// Interface groups the STACKIT services used by the reconcilers.
+type Interface interface {
+	Servers() ServerService
+	Networks() NetworkService
+	LoadBalancers() LoadBalancerService
+	SecurityGroups() SecurityGroupService
+	Volumes() VolumeService
+	Images() ImageService
+	AvailabilityZones() AvailabilityZoneService
+	PublicIPs() PublicIPService
+	ServerGroups() ServerGroupService
+	ManagedClusters() ManagedClusterService
+}
+
+// Factory returns an Interface operating on the given scope.
+type Factory func(ctx context.Context, scope Scope) (Interface, error)
+
+// ObjectStorageScope identifies a bucket of STACKIT Object Storage or
+// another S3-compatible object storage.
+type ObjectStorageScope struct {
+	// Endpoint is the base URL of the S3 API. If empty, the STACKIT Object
+	// Storage endpoint of Region is used.
+	Endpoint string
+
+	// Region is the region requests are signed for, for example "eu01".
+	Region string
+
+	// Bucket is the name of the bucket.
+	Bucket string
+
+	// Credentials authenticate the requests.
+	Credentials ObjectStorageCredentials
+}
+
+// ObjectStorageFactory returns an ObjectStorage operating on the given bucket.
+type ObjectStorageFactory func(ctx context.Context, scope ObjectStorageScope) (ObjectStorage, error)
+
+// ObjectStorage stores objects in a bucket.
+type ObjectStorage interface {
+	PutObject(ctx context.Context, key string, data []byte) error
+	DeleteObject(ctx context.Context, key string) error
+
+	// PresignGetObject returns a URL downloading the object without further
+	// authentication until it expires.
+	PresignGetObject(key string, expires time.Duration) (string, error)
+}
+
+// ServerService manages compute servers.
+type ServerService interface {
+	Create(ctx context.Context, req CreateServerRequest) (*Server, error)
+	Get(ctx context.Context, id string) (*Server, error)
+	List(ctx context.Context, labels map[string]string) ([]Server, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// NetworkService manages networks.
+type NetworkService interface {
+	Create(ctx context.Context, req CreateNetworkRequest) (*Network, error)
+	Get(ctx context.Context, id string) (*Network, error)
+	List(ctx context.Context, labels map[string]string) ([]Network, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// LoadBalancerService manages load balancers. Load balancers are identified
+// by their name.
+type LoadBalancerService interface {
+	Create(ctx context.Context, lb LoadBalancer) (*LoadBalancer, error)
+	Get(ctx context.Context, name string) (*LoadBalancer, error)
+	Update(ctx context.Context, lb LoadBalancer) (*LoadBalancer, error)
+	Delete(ctx context.Context, name string) error
+}
+
+// SecurityGroupService manages security groups and their rules.
+type SecurityGroupService interface {
+	Create(ctx context.Context, req CreateSecurityGroupRequest) (*SecurityGroup, error)
+	Get(ctx context.Context, id string) (*SecurityGroup, error)
+	List(ctx context.Context, labels map[string]string) ([]SecurityGroup, error)
+	Delete(ctx context.Context, id string) error
+	CreateRule(ctx context.Context, groupID string, rule SecurityGroupRule) (*SecurityGroupRule, error)
+	DeleteRule(ctx context.Context, groupID, ruleID string) error
+}
+
+// VolumeService manages block volumes.
+type VolumeService interface {
+	Create(ctx context.Context, req CreateVolumeRequest) (*Volume, error)
+	Get(ctx context.Context, id string) (*Volume, error)
+	List(ctx context.Context, labels map[string]string) ([]Volume, error)
+	Delete(ctx context.Context, id string) error
+	Attach(ctx context.Context, serverID, volumeID string) error
+	Detach(ctx context.Context, serverID, volumeID string) error
+
+	// UpdateLabels replaces the labels of a volume.
+	UpdateLabels(ctx context.Context, id string, labels map[string]string) (*Volume, error)
+}
+
+// ImageService looks up images.
+type ImageService interface {
+	Get(ctx context.Context, id string) (*Image, error)
+	List(ctx context.Context) ([]Image, error)
+}
+
+// PublicIPService manages public IPs and their association with servers.
+type PublicIPService interface {
+	Create(ctx context.Context, req CreatePublicIPRequest) (*PublicIP, error)
+	Get(ctx context.Context, id string) (*PublicIP, error)
+	List(ctx context.Context, labels map[string]string) ([]PublicIP, error)
+	Delete(ctx context.Context, id string) error
+	Associate(ctx context.Context, serverID, id string) error
+	Disassociate(ctx context.Context, serverID, id string) error
+}
+
+// ServerGroupService manages server groups, which control on which
+// hypervisors their member servers are placed. Server groups have no labels
+// and are identified by name.
+type ServerGroupService interface {
+	Create(ctx context.Context, req CreateServerGroupRequest) (*ServerGroup, error)
+	Get(ctx context.Context, id string) (*ServerGroup, error)
+	List(ctx context.Context) ([]ServerGroup, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// ManagedClusterService manages STACKIT Kubernetes Engine (SKE) clusters.
+// SKE clusters have no labels and are identified by name. Their node pools
+// are part of the cluster and updated with it.
+type ManagedClusterService interface {
+	CreateOrUpdate(ctx context.Context, cluster ManagedCluster) (*ManagedCluster, error)
+	Get(ctx context.Context, name string) (*ManagedCluster, error)
+	Delete(ctx context.Context, name string) error
+	CreateKubeconfig(ctx context.Context, name string, expiration time.Duration) (*Kubeconfig, error)
+}
+
+// AvailabilityZoneService lists the availability zones of the region.
+type AvailabilityZoneService interface {
+	List(ctx context.Context) ([]string, error)
+}
+
+// ErrNotFound is returned when a STACKIT resource does not exist.
+var ErrNotFound = errors.New("not found")
+
+// APIError is returned when a STACKIT API responds with an unexpected status.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Message is the error message returned by the API.
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("STACKIT API error (%d): %s", e.StatusCode, e.Message)
+}
+
+// Is reports whether the error matches target. An APIError with status
+// 404 matches ErrNotFound.
+func (e *APIError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// IsNotFound returns true if err indicates that a STACKIT resource does not exist.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsConflict returns true if err indicates that a STACKIT resource is in
+// use or already exists.
+func IsConflict(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict
+}
+
+// MatchLabels returns true if have contains all key/value pairs of want.
+func MatchLabels(have, want map[string]string) bool {
+	for k, v := range want {
+		if have[k] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloud
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestProviderID(t *testing.T) {
+	g := NewWithT(t)
+
+	id, err := ServerIDFromProviderID(ProviderID("a1b2"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(id).To(Equal("a1b2"))
+
+	for _, providerID := range []string{"", "stackit:///", "aws:///a1b2", "stackit://a1b2", "stackit:///eu01/a1b2"} {
+		_, err := ServerIDFromProviderID(providerID)
+		g.Expect(err).To(HaveOccurred(), providerID)
+	}
+}
+
+func TestCredentialsFromSecretData(t *testing.T) {
+	g := NewWithT(t)
+
+	creds, err := CredentialsFromSecretData(map[string][]byte{TokenSecretKey: []byte("token\n")})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(creds).To(Equal(&Credentials{Token: "token"}))
+
+	creds, err = CredentialsFromSecretData(map[string][]byte{
+		ServiceAccountKeySecretKey: []byte(`{"id":"key"}`),
+		PrivateKeySecretKey:        []byte("pem"),
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(creds).To(Equal(&Credentials{ServiceAccountKey: []byte(`{"id":"key"}`), PrivateKey: []byte("pem")}))
+
+	_, err = CredentialsFromSecretData(map[string][]byte{})
+	g.Expect(err).To(HaveOccurred())
+	_, err = CredentialsFromSecretData(map[string][]byte{
+		ServiceAccountKeySecretKey: []byte(`{"id":"key"}`),
+		TokenSecretKey:             []byte("token"),
+	})
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestObjectStorageCredentialsFromSecretData(t *testing.T) {
+	g := NewWithT(t)
+
+	creds, err := ObjectStorageCredentialsFromSecretData(map[string][]byte{
+		AccessKeyIDSecretKey:     []byte("key\n"),
+		SecretAccessKeySecretKey: []byte("secret"),
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(creds).To(Equal(&ObjectStorageCredentials{AccessKeyID: "key", SecretAccessKey: "secret"}))
+
+	_, err = ObjectStorageCredentialsFromSecretData(map[string][]byte{AccessKeyIDSecretKey: []byte("key")})
+	g.Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloud
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Keys of the Secrets holding STACKIT credentials.
+const (
+	// ServiceAccountKeySecretKey holds a service account key in the JSON
+	// format created by the STACKIT portal.
+	ServiceAccountKeySecretKey = "serviceAccountKey"
+
+	// PrivateKeySecretKey holds the PEM encoded private key of the service
+	// account key, if the key itself does not contain it.
+	PrivateKeySecretKey = "privateKey"
+
+	// TokenSecretKey holds a static service account access token.
+	TokenSecretKey = "token"
+
+	// AccessKeyIDSecretKey holds the access key ID of object storage
+	// credentials.
+	AccessKeyIDSecretKey = "accessKeyID"
+
+	// SecretAccessKeySecretKey holds the secret access key of object storage
+	// credentials.
+	SecretAccessKeySecretKey = "secretAccessKey"
+)
+
+// Credentials authenticate requests to the STACKIT APIs. Exactly one of
+// ServiceAccountKey and Token is set.
+type Credentials struct {
+	// ServiceAccountKey is a service account key in the JSON format created
+	// by the STACKIT portal. Access tokens are obtained and refreshed with it.
+	ServiceAccountKey []byte
+
+	// PrivateKey is the PEM encoded private key of ServiceAccountKey. It is
+	// only needed if the service account key does not contain it.
+	PrivateKey []byte
+
+	// Token is a static service account access token.
+	Token string
+}
+
+// CredentialsFromSecretData returns the credentials stored in the data of a
+// Secret under the ServiceAccountKeySecretKey, PrivateKeySecretKey and
+// TokenSecretKey keys.
+func CredentialsFromSecretData(data map[string][]byte) (*Credentials, error) {
+	key := bytes.TrimSpace(data[ServiceAccountKeySecretKey])
+	token := strings.TrimSpace(string(data[TokenSecretKey]))
+	switch {
+	case len(key) > 0 && token != "":
+		return nil, fmt.Errorf("only one of %q and %q may be set", ServiceAccountKeySecretKey, TokenSecretKey)
+	case len(key) > 0:
+		return &Credentials{ServiceAccountKey: key, PrivateKey: data[PrivateKeySecretKey]}, nil
+	case token != "":
+		return &Credentials{Token: token}, nil
+	default:
+		return nil, fmt.Errorf("one of %q and %q must be set", ServiceAccountKeySecretKey, TokenSecretKey)
+	}
+}
+
+// ObjectStorageCredentials authenticate requests to an S3-compatible object
+// storage.
+type ObjectStorageCredentials struct {
+	// AccessKeyID identifies the access key.
+	AccessKeyID string
+
+	// SecretAccessKey signs the requests.
+	SecretAccessKey string
+}
+
+// ObjectStorageCredentialsFromSecretData returns the object storage
+// credentials stored in the data of a Secret under the AccessKeyIDSecretKey
+// and SecretAccessKeySecretKey keys.
+func ObjectStorageCredentialsFromSecretData(data map[string][]byte) (*ObjectStorageCredentials, error) {
+	creds := &ObjectStorageCredentials{
+		AccessKeyID:     strings.TrimSpace(string(data[AccessKeyIDSecretKey])),
+		SecretAccessKey: strings.TrimSpace(string(data[SecretAccessKeySecretKey])),
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return nil, fmt.Errorf("%q and %q must be set", AccessKeyIDSecretKey, SecretAccessKeySecretKey)
+	}
+	return creds, nil
+}
@@ -0,0 +1,909 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides an in-memory implementation of cloud.Interface for
+// unit tests. Resources are created in their final state, for example
+// servers are ACTIVE right away.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+)
+
+// Cloud is an in-memory STACKIT project. It is safe for concurrent use.
+type Cloud struct {
+	mu sync.Mutex
+
+	nextID         int
+	servers        map[string]*cloud.Server
+	networks       map[string]*cloud.Network
+	loadBalancers  map[string]*cloud.LoadBalancer
+	securityGroups map[string]*cloud.SecurityGroup
+	volumes        map[string]*cloud.Volume
+	images         map[string]*cloud.Image
+	publicIPs      map[string]*cloud.PublicIP
+	serverGroups   map[string]*cloud.ServerGroup
+	clusters       map[string]*cloud.ManagedCluster
+	zones          []string
+
+	// deleteOnTermination holds the IDs of boot volumes that are deleted
+	// together with their server.
+	deleteOnTermination map[string]bool
+}
+
+var _ cloud.Interface = &Cloud{}
+
+// New returns an empty Cloud.
+func New() *Cloud {
+	return &Cloud{
+		servers:        map[string]*cloud.Server{},
+		networks:       map[string]*cloud.Network{},
+		loadBalancers:  map[string]*cloud.LoadBalancer{},
+		securityGroups: map[string]*cloud.SecurityGroup{},
+		volumes:        map[string]*cloud.Volume{},
+		images:         map[string]*cloud.Image{},
+		publicIPs:      map[string]*cloud.PublicIP{},
+		serverGroups:   map[string]*cloud.ServerGroup{},
+		clusters:       map[string]*cloud.ManagedCluster{},
+
+		deleteOnTermination: map[string]bool{},
+	}
+}
+
+// Factory returns a cloud.Factory that always returns c, regardless of scope.
+func (c *Cloud) Factory() cloud.Factory {
+	return func(context.Context, cloud.Scope) (cloud.Interface, error) {
+		return c, nil
+	}
+}
+
+// AddImage registers an image and returns its ID.
+func (c *Cloud) AddImage(image cloud.Image) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if image.ID == "" {
+		image.ID = c.newID("image")
+	}
+	if image.Status == "" {
+		image.Status = cloud.ImageStatusAvailable
+	}
+	c.images[image.ID] = &image
+	return image.ID
+}
+
+// SetAvailabilityZones sets the availability zones of the region.
+func (c *Cloud) SetAvailabilityZones(zones ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.zones = slices.Clone(zones)
+}
+
+// Servers implements cloud.Interface.
+func (c *Cloud) Servers() cloud.ServerService { return &servers{c} }
+
+// Networks implements cloud.Interface.
+func (c *Cloud) Networks() cloud.NetworkService { return &networks{c} }
+
+// LoadBalancers implements cloud.Interface.
+func (c *Cloud) LoadBalancers() cloud.LoadBalancerService { return &loadBalancers{c} }
+
+// SecurityGroups implements cloud.Interface.
+func (c *Cloud) SecurityGroups() cloud.SecurityGroupService { return &securityGroups{c} }
+
+// Volumes implements cloud.Interface.
+func (c *Cloud) Volumes() cloud.VolumeService { return &volumes{c} }
+
+// Images implements cloud.Interface.
+func (c *Cloud) Images() cloud.ImageService { return &images{c} }
+
+// AvailabilityZones implements cloud.Interface.
+func (c *Cloud) AvailabilityZones() cloud.AvailabilityZoneService { return &availabilityZones{c} }
+
+// PublicIPs implements cloud.Interface.
+func (c *Cloud) PublicIPs() cloud.PublicIPService { return &publicIPs{c} }
+
+// ServerGroups implements cloud.Interface.
+func (c *Cloud) ServerGroups() cloud.ServerGroupService { return &serverGroups{c} }
+
+// ManagedClusters implements cloud.Interface.
+func (c *Cloud) ManagedClusters() cloud.ManagedClusterService { return &managedClusters{c} }
+
+// newID returns a new unique ID. c.mu must be held.
+func (c *Cloud) newID(kind string) string {
+	c.nextID++
+	return fmt.Sprintf("%s-%d", kind, c.nextID)
+}
+
+// newIPv4 returns a new private address. c.mu must be held.
+func (c *Cloud) newIPv4() string {
+	c.nextID++
+	return fmt.Sprintf("10.0.%d.%d", c.nextID/250, c.nextID%250+2)
+}
+
+func notFound(kind, id string) error {
+	return fmt.Errorf("%s %q: %w", kind, id, cloud.ErrNotFound)
+}
+
+type servers struct{ c *Cloud }
+
+func (s *servers) Create(_ context.Context, req cloud.CreateServerRequest) (*cloud.Server, error) {
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+
+	if req.ImageID != "" && s.c.images[req.ImageID] == nil {
+		return nil, notFound("image", req.ImageID)
+	}
+	for _, id := range req.VolumeIDs {
+		volume, ok := s.c.volumes[id]
+		if !ok {
+			return nil, notFound("volume", id)
+		}
+		if volume.ServerID != "" {
+			return nil, &cloud.APIError{StatusCode: 409, Message: fmt.Sprintf("volume %q is attached", id)}
+		}
+	}
+	if req.ServerGroupID != "" && s.c.serverGroups[req.ServerGroupID] == nil {
+		return nil, notFound("server group", req.ServerGroupID)
+	}
+	server := &cloud.Server{
+		ID:               s.c.newID("server"),
+		Name:             req.Name,
+		MachineType:      req.MachineType,
+		ImageID:          req.ImageID,
+		AvailabilityZone: req.AvailabilityZone,
+		Status:           cloud.ServerStatusActive,
+		Labels:           maps.Clone(req.Labels),
+		ServerGroupID:    req.ServerGroupID,
+	}
+	if req.NetworkID != "" {
+		server.NICs = []cloud.NIC{{
+			ID:        s.c.newID("nic"),
+			NetworkID: req.NetworkID,
+			IPv4:      s.c.newIPv4(),
+		}}
+	}
+	if req.BootVolume != nil {
+		volume := &cloud.Volume{
+			ID:               s.c.newID("volume"),
+			Name:             req.Name,
+			Size:             req.BootVolume.Size,
+			PerformanceClass: req.BootVolume.PerformanceClass,
+			AvailabilityZone: req.AvailabilityZone,
+			Status:           cloud.VolumeStatusAttached,
+			ServerID:         server.ID,
+			Labels:           maps.Clone(req.Labels),
+		}
+		s.c.volumes[volume.ID] = volume
+		server.VolumeIDs = append(server.VolumeIDs, volume.ID)
+		if req.BootVolume.DeleteOnTermination {
+			s.c.deleteOnTermination[volume.ID] = true
+		}
+	}
+	for _, id := range req.VolumeIDs {
+		volume := s.c.volumes[id]
+		volume.ServerID = server.ID
+		volume.Status = cloud.VolumeStatusAttached
+		server.VolumeIDs = append(server.VolumeIDs, id)
+	}
+	s.c.servers[server.ID] = server
+	return cloneServer(server), nil
+}
+
+func (s *servers) Get(_ context.Context, id string) (*cloud.Server, error) {
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+
+	server, ok := s.c.servers[id]
+	if !ok {
+		return nil, notFound("server", id)
+	}
+	return cloneServer(server), nil
+}
+
+func (s *servers) List(_ context.Context, labels map[string]string) ([]cloud.Server, error) {
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+
+	var out []cloud.Server
+	for _, id := range slices.Sorted(maps.Keys(s.c.servers)) {
+		if server := s.c.servers[id]; cloud.MatchLabels(server.Labels, labels) {
+			out = append(out, *cloneServer(server))
+		}
+	}
+	return out, nil
+}
+
+func (s *servers) Delete(_ context.Context, id string) error {
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+
+	server, ok := s.c.servers[id]
+	if !ok {
+		return notFound("server", id)
+	}
+	for _, volumeID := range server.VolumeIDs {
+		if s.c.deleteOnTermination[volumeID] {
+			delete(s.c.volumes, volumeID)
+			delete(s.c.deleteOnTermination, volumeID)
+			continue
+		}
+		if volume := s.c.volumes[volumeID]; volume != nil {
+			volume.ServerID = ""
+			volume.Status = cloud.VolumeStatusAvailable
+		}
+	}
+	for _, ip := range s.c.publicIPs {
+		for _, nic := range server.NICs {
+			if ip.NetworkInterface == nic.ID {
+				ip.NetworkInterface = ""
+			}
+		}
+	}
+	delete(s.c.servers, id)
+	return nil
+}
+
+func cloneServer(in *cloud.Server) *cloud.Server {
+	out := *in
+	out.Labels = maps.Clone(in.Labels)
+	out.NICs = slices.Clone(in.NICs)
+	out.VolumeIDs = slices.Clone(in.VolumeIDs)
+	return &out
+}
+
+type networks struct{ c *Cloud }
+
+func (n *networks) Create(_ context.Context, req cloud.CreateNetworkRequest) (*cloud.Network, error) {
+	n.c.mu.Lock()
+	defer n.c.mu.Unlock()
+
+	network := &cloud.Network{
+		ID:          n.c.newID("network"),
+		Name:        req.Name,
+		NameServers: slices.Clone(req.NameServers),
+		Routed:      req.Routed,
+		Status:      cloud.NetworkStatusCreated,
+		Labels:      maps.Clone(req.Labels),
+	}
+	if req.IPv4Prefix != "" {
+		network.Prefixes = []string{req.IPv4Prefix}
+	}
+	if req.Routed {
+		network.PublicIP = fmt.Sprintf("192.0.2.%d", n.c.nextID%250+1)
+	}
+	n.c.networks[network.ID] = network
+	return cloneNetwork(network), nil
+}
+
+func (n *networks) Get(_ context.Context, id string) (*cloud.Network, error) {
+	n.c.mu.Lock()
+	defer n.c.mu.Unlock()
+
+	network, ok := n.c.networks[id]
+	if !ok {
+		return nil, notFound("network", id)
+	}
+	return cloneNetwork(network), nil
+}
+
+func (n *networks) List(_ context.Context, labels map[string]string) ([]cloud.Network, error) {
+	n.c.mu.Lock()
+	defer n.c.mu.Unlock()
+
+	var out []cloud.Network
+	for _, id := range slices.Sorted(maps.Keys(n.c.networks)) {
+		if network := n.c.networks[id]; cloud.MatchLabels(network.Labels, labels) {
+			out = append(out, *cloneNetwork(network))
+		}
+	}
+	return out, nil
+}
+
+func (n *networks) Delete(_ context.Context, id string) error {
+	n.c.mu.Lock()
+	defer n.c.mu.Unlock()
+
+	if _, ok := n.c.networks[id]; !ok {
+		return notFound("network", id)
+	}
+	for _, server := range n.c.servers {
+		for _, nic := range server.NICs {
+			if nic.NetworkID == id {
+				return &cloud.APIError{StatusCode: 409, Message: fmt.Sprintf("network %q is in use", id)}
+			}
+		}
+	}
+	delete(n.c.networks, id)
+	return nil
+}
+
+func cloneNetwork(in *cloud.Network) *cloud.Network {
+	out := *in
+	out.Labels = maps.Clone(in.Labels)
+	out.Prefixes = slices.Clone(in.Prefixes)
+	out.NameServers = slices.Clone(in.NameServers)
+	return &out
+}
+
+type loadBalancers struct{ c *Cloud }
+
+func (l *loadBalancers) Create(_ context.Context, lb cloud.LoadBalancer) (*cloud.LoadBalancer, error) {
+	l.c.mu.Lock()
+	defer l.c.mu.Unlock()
+
+	if _, ok := l.c.loadBalancers[lb.Name]; ok {
+		return nil, &cloud.APIError{StatusCode: 409, Message: fmt.Sprintf("load balancer %q already exists", lb.Name)}
+	}
+	created := cloneLoadBalancer(&lb)
+	created.Status = cloud.LoadBalancerStatusReady
+	created.PrivateAddress = l.c.newIPv4()
+	if !created.Options.PrivateNetworkOnly {
+		created.ExternalAddress = fmt.Sprintf("198.51.100.%d", l.c.nextID%250+1)
+	}
+	l.c.loadBalancers[lb.Name] = created
+	return cloneLoadBalancer(created), nil
+}
+
+func (l *loadBalancers) Get(_ context.Context, name string) (*cloud.LoadBalancer, error) {
+	l.c.mu.Lock()
+	defer l.c.mu.Unlock()
+
+	lb, ok := l.c.loadBalancers[name]
+	if !ok {
+		return nil, notFound("load balancer", name)
+	}
+	return cloneLoadBalancer(lb), nil
+}
+
+func (l *loadBalancers) Update(_ context.Context, lb cloud.LoadBalancer) (*cloud.LoadBalancer, error) {
+	l.c.mu.Lock()
+	defer l.c.mu.Unlock()
+
+	existing, ok := l.c.loadBalancers[lb.Name]
+	if !ok {
+		return nil, notFound("load balancer", lb.Name)
+	}
+	updated := cloneLoadBalancer(&lb)
+	updated.Status = existing.Status
+	updated.ExternalAddress = existing.ExternalAddress
+	updated.PrivateAddress = existing.PrivateAddress
+	l.c.loadBalancers[lb.Name] = updated
+	return cloneLoadBalancer(updated), nil
+}
+
+func (l *loadBalancers) Delete(_ context.Context, name string) error {
+	l.c.mu.Lock()
+	defer l.c.mu.Unlock()
+
+	if _, ok := l.c.loadBalancers[name]; !ok {
+		return notFound("load balancer", name)
+	}
+	delete(l.c.loadBalancers, name)
+	return nil
+}
+
+func cloneLoadBalancer(in *cloud.LoadBalancer) *cloud.LoadBalancer {
+	out := *in
+	out.Labels = maps.Clone(in.Labels)
+	out.Errors = slices.Clone(in.Errors)
+	out.Listeners = slices.Clone(in.Listeners)
+	out.Options.AllowedSourceRanges = slices.Clone(in.Options.AllowedSourceRanges)
+	out.TargetPools = make([]cloud.TargetPool, len(in.TargetPools))
+	for i, pool := range in.TargetPools {
+		pool.Targets = slices.Clone(pool.Targets)
+		if pool.HealthCheck != nil {
+			hc := *pool.HealthCheck
+			pool.HealthCheck = &hc
+		}
+		out.TargetPools[i] = pool
+	}
+	return &out
+}
+
+type securityGroups struct{ c *Cloud }
+
+func (s *securityGroups) Create(
+	_ context.Context, req cloud.CreateSecurityGroupRequest,
+) (*cloud.SecurityGroup, error) {
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+
+	group := &cloud.SecurityGroup{
+		ID:          s.c.newID("security-group"),
+		Name:        req.Name,
+		Description: req.Description,
+		Stateful:    req.Stateful,
+		Labels:      maps.Clone(req.Labels),
+	}
+	s.c.securityGroups[group.ID] = group
+	return cloneSecurityGroup(group), nil
+}
+
+func (s *securityGroups) Get(_ context.Context, id string) (*cloud.SecurityGroup, error) {
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+
+	group, ok := s.c.securityGroups[id]
+	if !ok {
+		return nil, notFound("security group", id)
+	}
+	return cloneSecurityGroup(group), nil
+}
+
+func (s *securityGroups) List(_ context.Context, labels map[string]string) ([]cloud.SecurityGroup, error) {
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+
+	var out []cloud.SecurityGroup
+	for _, id := range slices.Sorted(maps.Keys(s.c.securityGroups)) {
+		if group := s.c.securityGroups[id]; cloud.MatchLabels(group.Labels, labels) {
+			out = append(out, *cloneSecurityGroup(group))
+		}
+	}
+	return out, nil
+}
+
+func (s *securityGroups) Delete(_ context.Context, id string) error {
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+
+	if _, ok := s.c.securityGroups[id]; !ok {
+		return notFound("security group", id)
+	}
+	delete(s.c.securityGroups, id)
+	return nil
+}
+
+func (s *securityGroups) CreateRule(
+	_ context.Context, groupID string, rule cloud.SecurityGroupRule,
+) (*cloud.SecurityGroupRule, error) {
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+
+	group, ok := s.c.securityGroups[groupID]
+	if !ok {
+		return nil, notFound("security group", groupID)
+	}
+	rule.ID = s.c.newID("rule")
+	group.Rules = append(group.Rules, rule)
+	return &rule, nil
+}
+
+func (s *securityGroups) DeleteRule(_ context.Context, groupID, ruleID string) error {
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+
+	group, ok := s.c.securityGroups[groupID]
+	if !ok {
+		return notFound("security group", groupID)
+	}
+	i := slices.IndexFunc(group.Rules, func(r cloud.SecurityGroupRule) bool { return r.ID == ruleID })
+	if i < 0 {
+		return notFound("security group rule", ruleID)
+	}
+	group.Rules = slices.Delete(group.Rules, i, i+1)
+	return nil
+}
+
+func cloneSecurityGroup(in *cloud.SecurityGroup) *cloud.SecurityGroup {
+	out := *in
+	out.Labels = maps.Clone(in.Labels)
+	out.Rules = slices.Clone(in.Rules)
+	return &out
+}
+
+type volumes struct{ c *Cloud }
+
+func (v *volumes) Create(_ context.Context, req cloud.CreateVolumeRequest) (*cloud.Volume, error) {
+	v.c.mu.Lock()
+	defer v.c.mu.Unlock()
+
+	volume := &cloud.Volume{
+		ID:               v.c.newID("volume"),
+		Name:             req.Name,
+		Size:             req.Size,
+		PerformanceClass: req.PerformanceClass,
+		AvailabilityZone: req.AvailabilityZone,
+		Status:           cloud.VolumeStatusAvailable,
+		Encrypted:        req.Encryption != nil,
+		Labels:           maps.Clone(req.Labels),
+	}
+	v.c.volumes[volume.ID] = volume
+	return cloneVolume(volume), nil
+}
+
+func (v *volumes) Get(_ context.Context, id string) (*cloud.Volume, error) {
+	v.c.mu.Lock()
+	defer v.c.mu.Unlock()
+
+	volume, ok := v.c.volumes[id]
+	if !ok {
+		return nil, notFound("volume", id)
+	}
+	return cloneVolume(volume), nil
+}
+
+func (v *volumes) List(_ context.Context, labels map[string]string) ([]cloud.Volume, error) {
+	v.c.mu.Lock()
+	defer v.c.mu.Unlock()
+
+	var out []cloud.Volume
+	for _, id := range slices.Sorted(maps.Keys(v.c.volumes)) {
+		if volume := v.c.volumes[id]; cloud.MatchLabels(volume.Labels, labels) {
+			out = append(out, *cloneVolume(volume))
+		}
+	}
+	return out, nil
+}
+
+func (v *volumes) Delete(_ context.Context, id string) error {
+	v.c.mu.Lock()
+	defer v.c.mu.Unlock()
+
+	volume, ok := v.c.volumes[id]
+	if !ok {
+		return notFound("volume", id)
+	}
+	if volume.ServerID != "" {
+		return &cloud.APIError{StatusCode: 409, Message: fmt.Sprintf("volume %q is attached", id)}
+	}
+	delete(v.c.volumes, id)
+	return nil
+}
+
+func (v *volumes) Attach(_ context.Context, serverID, volumeID string) error {
+	v.c.mu.Lock()
+	defer v.c.mu.Unlock()
+
+	server, ok := v.c.servers[serverID]
+	if !ok {
+		return notFound("server", serverID)
+	}
+	volume, ok := v.c.volumes[volumeID]
+	if !ok {
+		return notFound("volume", volumeID)
+	}
+	if volume.ServerID == serverID {
+		return nil
+	}
+	if volume.ServerID != "" {
+		return &cloud.APIError{StatusCode: 409, Message: fmt.Sprintf("volume %q is attached", volumeID)}
+	}
+	volume.ServerID = serverID
+	volume.Status = cloud.VolumeStatusAttached
+	server.VolumeIDs = append(server.VolumeIDs, volumeID)
+	return nil
+}
+
+func (v *volumes) Detach(_ context.Context, serverID, volumeID string) error {
+	v.c.mu.Lock()
+	defer v.c.mu.Unlock()
+
+	server, ok := v.c.servers[serverID]
+	if !ok {
+		return notFound("server", serverID)
+	}
+	volume, ok := v.c.volumes[volumeID]
+	if !ok || volume.ServerID != serverID {
+		return notFound("volume attachment", volumeID)
+	}
+	volume.ServerID = ""
+	volume.Status = cloud.VolumeStatusAvailable
+	server.VolumeIDs = slices.DeleteFunc(server.VolumeIDs, func(id string) bool { return id == volumeID })
+	return nil
+}
+
+func (v *volumes) UpdateLabels(_ context.Context, id string, labels map[string]string) (*cloud.Volume, error) {
+	v.c.mu.Lock()
+	defer v.c.mu.Unlock()
+
+	volume, ok := v.c.volumes[id]
+	if !ok {
+		return nil, notFound("volume", id)
+	}
+	volume.Labels = maps.Clone(labels)
+	return cloneVolume(volume), nil
+}
+
+func cloneVolume(in *cloud.Volume) *cloud.Volume {
+	out := *in
+	out.Labels = maps.Clone(in.Labels)
+	return &out
+}
+
+type images struct{ c *Cloud }
+
+func (i *images) Get(_ context.Context, id string) (*cloud.Image, error) {
+	i.c.mu.Lock()
+	defer i.c.mu.Unlock()
+
+	image, ok := i.c.images[id]
+	if !ok {
+		return nil, notFound("image", id)
+	}
+	out := *image
+	out.Labels = maps.Clone(image.Labels)
+	return &out, nil
+}
+
+func (i *images) List(_ context.Context) ([]cloud.Image, error) {
+	i.c.mu.Lock()
+	defer i.c.mu.Unlock()
+
+	var out []cloud.Image
+	for _, id := range slices.Sorted(maps.Keys(i.c.images)) {
+		image := *i.c.images[id]
+		image.Labels = maps.Clone(image.Labels)
+		out = append(out, image)
+	}
+	return out, nil
+}
+
+type publicIPs struct{ c *Cloud }
+
+func (p *publicIPs) Create(_ context.Context, req cloud.CreatePublicIPRequest) (*cloud.PublicIP, error) {
+	p.c.mu.Lock()
+	defer p.c.mu.Unlock()
+
+	ip := &cloud.PublicIP{
+		ID:     p.c.newID("public-ip"),
+		Labels: maps.Clone(req.Labels),
+	}
+	ip.IP = fmt.Sprintf("192.0.2.%d", p.c.nextID%250+1)
+	p.c.publicIPs[ip.ID] = ip
+	return clonePublicIP(ip), nil
+}
+
+func (p *publicIPs) Get(_ context.Context, id string) (*cloud.PublicIP, error) {
+	p.c.mu.Lock()
+	defer p.c.mu.Unlock()
+
+	ip, ok := p.c.publicIPs[id]
+	if !ok {
+		return nil, notFound("public IP", id)
+	}
+	return clonePublicIP(ip), nil
+}
+
+func (p *publicIPs) List(_ context.Context, labels map[string]string) ([]cloud.PublicIP, error) {
+	p.c.mu.Lock()
+	defer p.c.mu.Unlock()
+
+	var out []cloud.PublicIP
+	for _, id := range slices.Sorted(maps.Keys(p.c.publicIPs)) {
+		if ip := p.c.publicIPs[id]; cloud.MatchLabels(ip.Labels, labels) {
+			out = append(out, *clonePublicIP(ip))
+		}
+	}
+	return out, nil
+}
+
+func (p *publicIPs) Delete(_ context.Context, id string) error {
+	p.c.mu.Lock()
+	defer p.c.mu.Unlock()
+
+	ip, ok := p.c.publicIPs[id]
+	if !ok {
+		return notFound("public IP", id)
+	}
+	if ip.NetworkInterface != "" {
+		return &cloud.APIError{StatusCode: 409, Message: fmt.Sprintf("public IP %q is associated", id)}
+	}
+	delete(p.c.publicIPs, id)
+	return nil
+}
+
+func (p *publicIPs) Associate(_ context.Context, serverID, id string) error {
+	p.c.mu.Lock()
+	defer p.c.mu.Unlock()
+
+	server, ok := p.c.servers[serverID]
+	if !ok {
+		return notFound("server", serverID)
+	}
+	ip, ok := p.c.publicIPs[id]
+	if !ok {
+		return notFound("public IP", id)
+	}
+	if len(server.NICs) == 0 {
+		return &cloud.APIError{StatusCode: 400, Message: fmt.Sprintf("server %q has no network interface", serverID)}
+	}
+	nic := &server.NICs[0]
+	if ip.NetworkInterface != "" && ip.NetworkInterface != nic.ID {
+		return &cloud.APIError{StatusCode: 409, Message: fmt.Sprintf("public IP %q is associated with another server", id)}
+	}
+	ip.NetworkInterface = nic.ID
+	nic.PublicIP = ip.IP
+	return nil
+}
+
+func (p *publicIPs) Disassociate(_ context.Context, serverID, id string) error {
+	p.c.mu.Lock()
+	defer p.c.mu.Unlock()
+
+	server, ok := p.c.servers[serverID]
+	if !ok {
+		return notFound("server", serverID)
+	}
+	ip, ok := p.c.publicIPs[id]
+	if !ok || len(server.NICs) == 0 || ip.NetworkInterface != server.NICs[0].ID {
+		return notFound("public IP association", id)
+	}
+	ip.NetworkInterface = ""
+	server.NICs[0].PublicIP = ""
+	return nil
+}
+
+func clonePublicIP(in *cloud.PublicIP) *cloud.PublicIP {
+	out := *in
+	out.Labels = maps.Clone(in.Labels)
+	return &out
+}
+
+type serverGroups struct{ c *Cloud }
+
+func (g *serverGroups) Create(_ context.Context, req cloud.CreateServerGroupRequest) (*cloud.ServerGroup, error) {
+	g.c.mu.Lock()
+	defer g.c.mu.Unlock()
+
+	switch req.Policy {
+	case cloud.ServerGroupPolicyAntiAffinity, cloud.ServerGroupPolicySoftAntiAffinity:
+	default:
+		return nil, &cloud.APIError{StatusCode: 400, Message: fmt.Sprintf("invalid server group policy %q", req.Policy)}
+	}
+	group := &cloud.ServerGroup{
+		ID:     g.c.newID("server-group"),
+		Name:   req.Name,
+		Policy: req.Policy,
+	}
+	g.c.serverGroups[group.ID] = group
+	return g.c.serverGroup(group), nil
+}
+
+func (g *serverGroups) Get(_ context.Context, id string) (*cloud.ServerGroup, error) {
+	g.c.mu.Lock()
+	defer g.c.mu.Unlock()
+
+	group, ok := g.c.serverGroups[id]
+	if !ok {
+		return nil, notFound("server group", id)
+	}
+	return g.c.serverGroup(group), nil
+}
+
+func (g *serverGroups) List(_ context.Context) ([]cloud.ServerGroup, error) {
+	g.c.mu.Lock()
+	defer g.c.mu.Unlock()
+
+	var out []cloud.ServerGroup
+	for _, id := range slices.Sorted(maps.Keys(g.c.serverGroups)) {
+		out = append(out, *g.c.serverGroup(g.c.serverGroups[id]))
+	}
+	return out, nil
+}
+
+func (g *serverGroups) Delete(_ context.Context, id string) error {
+	g.c.mu.Lock()
+	defer g.c.mu.Unlock()
+
+	if _, ok := g.c.serverGroups[id]; !ok {
+		return notFound("server group", id)
+	}
+	delete(g.c.serverGroups, id)
+	return nil
+}
+
+// serverGroup returns a copy of group with its current members. c.mu must be
+// held.
+func (c *Cloud) serverGroup(group *cloud.ServerGroup) *cloud.ServerGroup {
+	out := *group
+	out.MemberIDs = nil
+	for _, id := range slices.Sorted(maps.Keys(c.servers)) {
+		if c.servers[id].ServerGroupID == group.ID {
+			out.MemberIDs = append(out.MemberIDs, id)
+		}
+	}
+	return &out
+}
+
+type managedClusters struct{ c *Cloud }
+
+func (m *managedClusters) CreateOrUpdate(_ context.Context, cluster cloud.ManagedCluster) (*cloud.ManagedCluster, error) {
+	m.c.mu.Lock()
+	defer m.c.mu.Unlock()
+
+	cluster.NodePools = slices.Clone(cluster.NodePools)
+	cluster.State = cloud.ManagedClusterStateHealthy
+	cluster.Errors = nil
+	m.c.clusters[cluster.Name] = &cluster
+	out := cluster
+	return &out, nil
+}
+
+func (m *managedClusters) Get(_ context.Context, name string) (*cloud.ManagedCluster, error) {
+	m.c.mu.Lock()
+	defer m.c.mu.Unlock()
+
+	cluster, ok := m.c.clusters[name]
+	if !ok {
+		return nil, notFound("cluster", name)
+	}
+	out := *cluster
+	out.NodePools = slices.Clone(cluster.NodePools)
+	return &out, nil
+}
+
+func (m *managedClusters) Delete(_ context.Context, name string) error {
+	m.c.mu.Lock()
+	defer m.c.mu.Unlock()
+
+	if _, ok := m.c.clusters[name]; !ok {
+		return notFound("cluster", name)
+	}
+	delete(m.c.clusters, name)
+	return nil
+}
+
+func (m *managedClusters) CreateKubeconfig(_ context.Context, name string, expiration time.Duration) (*cloud.Kubeconfig, error) {
+	m.c.mu.Lock()
+	defer m.c.mu.Unlock()
+
+	if _, ok := m.c.clusters[name]; !ok {
+		return nil, notFound("cluster", name)
+	}
+	return &cloud.Kubeconfig{
+		Kubeconfig: []byte(fmt.Sprintf(kubeconfigTemplate, name)),
+		ExpiresAt:  time.Now().Add(expiration),
+	}, nil
+}
+
+// kubeconfigTemplate is the kubeconfig returned for a cluster. The
+// placeholder is the name of the cluster.
+const kubeconfigTemplate = `apiVersion: v1
+kind: Config
+clusters:
+- name: %[1]s
+  cluster:
+    server: https://api.%[1]s.fake.ske.stackit.cloud
+contexts:
+- name: %[1]s
+  context:
+    cluster: %[1]s
+    user: %[1]s
+current-context: %[1]s
+users:
+- name: %[1]s
+  user:
+    token: fake
+`
+
+type availabilityZones struct{ c *Cloud }
+
+func (a *availabilityZones) List(_ context.Context) ([]string, error) {
+	a.c.mu.Lock()
+	defer a.c.mu.Unlock()
+
+	return slices.Clone(a.c.zones), nil
+}
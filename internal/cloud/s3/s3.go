@@ -0,0 +1,184 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package s3 implements cloud.ObjectStorage on top of the S3 API of STACKIT
+// Object Storage. Requests use path-style URLs and are signed with AWS
+// Signature Version 4, so any S3-compatible object storage can be used.
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+)
+
+const (
+	defaultTimeout = 30 * time.Second
+
+	// MaxPresignExpiry is the longest time a presigned URL can be valid.
+	MaxPresignExpiry = 7 * 24 * time.Hour
+)
+
+// DefaultEndpoint returns the endpoint of STACKIT Object Storage in region.
+func DefaultEndpoint(region string) string {
+	return fmt.Sprintf("https://object.storage.%s.onstackit.cloud", region)
+}
+
+// Options configures the object storage client.
+type Options struct {
+	// HTTPClient is the client used to send requests.
+	HTTPClient *http.Client
+}
+
+// NewFactory returns a cloud.ObjectStorageFactory creating clients with the
+// given options.
+func NewFactory(opts Options) cloud.ObjectStorageFactory {
+	return func(_ context.Context, scope cloud.ObjectStorageScope) (cloud.ObjectStorage, error) {
+		return New(scope, opts)
+	}
+}
+
+// New returns a client for the bucket of scope.
+func New(scope cloud.ObjectStorageScope, opts Options) (*Client, error) {
+	if scope.Region == "" {
+		return nil, fmt.Errorf("region must not be empty")
+	}
+	if scope.Bucket == "" {
+		return nil, fmt.Errorf("bucket must not be empty")
+	}
+	if scope.Endpoint == "" {
+		scope.Endpoint = DefaultEndpoint(scope.Region)
+	}
+	endpoint, err := url.Parse(strings.TrimSuffix(scope.Endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %q: %w", scope.Endpoint, err)
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint %q: expected an http or https URL", scope.Endpoint)
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{Timeout: defaultTimeout}
+	}
+	return &Client{
+		endpoint: endpoint,
+		bucket:   scope.Bucket,
+		client:   opts.HTTPClient,
+		signer: &signer{
+			accessKeyID:     scope.Credentials.AccessKeyID,
+			secretAccessKey: scope.Credentials.SecretAccessKey,
+			region:          scope.Region,
+		},
+		now: time.Now,
+	}, nil
+}
+
+// Client implements cloud.ObjectStorage for a single bucket.
+type Client struct {
+	endpoint *url.URL
+	bucket   string
+	client   *http.Client
+	signer   *signer
+	now      func() time.Time
+}
+
+var _ cloud.ObjectStorage = &Client{}
+
+// PutObject implements cloud.ObjectStorage.
+func (c *Client) PutObject(ctx context.Context, key string, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.objectURL(key), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	return c.do(req, hashHex(data))
+}
+
+// DeleteObject implements cloud.ObjectStorage. Deleting an object which
+// does not exist succeeds.
+func (c *Client) DeleteObject(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.objectURL(key), nil)
+	if err != nil {
+		return err
+	}
+	if err := c.do(req, hashHex(nil)); err != nil && !cloud.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// PresignGetObject implements cloud.ObjectStorage.
+func (c *Client) PresignGetObject(key string, expires time.Duration) (string, error) {
+	if expires < time.Second || expires > MaxPresignExpiry {
+		return "", fmt.Errorf("expiry %s must be between 1s and %s", expires, MaxPresignExpiry)
+	}
+	req, err := http.NewRequest(http.MethodGet, c.objectURL(key), nil)
+	if err != nil {
+		return "", err
+	}
+	c.signer.presign(req, c.now(), expires)
+	return req.URL.String(), nil
+}
+
+// objectURL returns the path-style URL of the object with the given key.
+func (c *Client) objectURL(key string) string {
+	u := *c.endpoint
+	u.Path += "/" + c.bucket + "/" + key
+	u.RawPath = canonicalURI(u.Path)
+	return u.String()
+}
+
+// do signs and sends req. Responses with a status code other than 2xx are
+// returned as *cloud.APIError.
+func (c *Client) do(req *http.Request, payloadHash string) error {
+	c.signer.sign(req, payloadHash, c.now())
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", req.Method, req.URL.Redacted(), err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("%s %s: reading response: %w", req.Method, req.URL.Redacted(), err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &cloud.APIError{StatusCode: resp.StatusCode, Message: errorMessage(body, resp.Status)}
+	}
+	return nil
+}
+
+// errorMessage returns the message of an S3 error response, or fallback if
+// body is not one.
+func errorMessage(body []byte, fallback string) string {
+	var s3Err struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	if err := xml.Unmarshal(body, &s3Err); err != nil || s3Err.Code == "" {
+		return fallback
+	}
+	if s3Err.Message == "" {
+		return s3Err.Code
+	}
+	return s3Err.Code + ": " + s3Err.Message
+}
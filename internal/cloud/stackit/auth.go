@@ -0,0 +1,343 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stackit
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+)
+
+const (
+	// DefaultTokenEndpoint is the endpoint issuing access tokens for service
+	// account keys.
+	DefaultTokenEndpoint = "https://service-account.api.stackit.cloud/token"
+
+	// assertionLifetime is the validity of the JWTs exchanged for access tokens.
+	assertionLifetime = 10 * time.Minute
+
+	// tokenLeeway is the time before its expiry in which an access token is
+	// refreshed.
+	tokenLeeway = time.Minute
+
+	// tokenSourceIdleTimeout is the time after which token sources of
+	// credentials which are no longer used are dropped from the cache.
+	tokenSourceIdleTimeout = time.Hour
+)
+
+// ServiceAccountKey is a service account key in the JSON format created by
+// the STACKIT portal.
+type ServiceAccountKey struct {
+	ID          string                       `json:"id"`
+	Credentials ServiceAccountKeyCredentials `json:"credentials"`
+}
+
+// ServiceAccountKeyCredentials are the credentials of a service account key.
+type ServiceAccountKeyCredentials struct {
+	// KID is the ID of the key, sent in the header of the assertions.
+	KID string `json:"kid"`
+	// Iss is the email address of the service account.
+	Iss string `json:"iss"`
+	// Sub is the ID of the service account.
+	Sub string `json:"sub"`
+	// Aud is the audience of the assertions.
+	Aud string `json:"aud"`
+	// PrivateKey is the PEM encoded private key. It is only included if the
+	// key pair was generated by STACKIT.
+	PrivateKey string `json:"privateKey,omitempty"`
+}
+
+// tokenSource provides the access tokens sent with each request.
+type tokenSource interface {
+	token(ctx context.Context) (string, error)
+}
+
+// staticToken is a tokenSource returning a fixed access token.
+type staticToken string
+
+func (t staticToken) token(context.Context) (string, error) { return string(t), nil }
+
+// keyFlow is a tokenSource exchanging JWTs signed with a service account key
+// for access tokens. Tokens are cached until shortly before they expire and
+// renewed with the refresh token if one was issued.
+type keyFlow struct {
+	key        ServiceAccountKey
+	privateKey *rsa.PrivateKey
+	endpoint   string
+	client     *http.Client
+	now        func() time.Time
+
+	mu           sync.Mutex
+	accessToken  string
+	refreshToken string
+	expiry       time.Time
+}
+
+// tokenResponse is the response of the token endpoint.
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+	TokenType    string `json:"token_type"`
+}
+
+// newTokenSource returns the tokenSource for creds.
+func newTokenSource(creds *cloud.Credentials, opts Options) (tokenSource, error) {
+	if creds.Token != "" {
+		return staticToken(creds.Token), nil
+	}
+	var key ServiceAccountKey
+	if err := json.Unmarshal(creds.ServiceAccountKey, &key); err != nil {
+		return nil, fmt.Errorf("parsing service account key: %w", err)
+	}
+	if key.Credentials.KID == "" || key.Credentials.Iss == "" || key.Credentials.Sub == "" {
+		return nil, fmt.Errorf("service account key is missing its credentials")
+	}
+	keyPEM := creds.PrivateKey
+	if len(keyPEM) == 0 {
+		keyPEM = []byte(key.Credentials.PrivateKey)
+	}
+	if len(keyPEM) == 0 {
+		return nil, fmt.Errorf("service account key does not contain a private key and none was provided")
+	}
+	privateKey, err := parsePrivateKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	return &keyFlow{
+		key:        key,
+		privateKey: privateKey,
+		endpoint:   opts.TokenEndpoint,
+		client:     opts.HTTPClient,
+		now:        time.Now,
+	}, nil
+}
+
+// parsePrivateKey parses a PEM encoded PKCS #1 or PKCS #8 RSA private key.
+func parsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("private key is not PEM encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing private key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+func (f *keyFlow) token(ctx context.Context) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.accessToken != "" && f.now().Before(f.expiry.Add(-tokenLeeway)) {
+		return f.accessToken, nil
+	}
+	if f.refreshToken != "" {
+		err := f.requestToken(ctx, url.Values{
+			"grant_type":    {"refresh_token"},
+			"refresh_token": {f.refreshToken},
+		})
+		if err == nil {
+			return f.accessToken, nil
+		}
+		// The refresh token may have expired or been revoked; start over
+		// with a new assertion.
+		f.refreshToken = ""
+	}
+
+	assertion, err := f.assertion()
+	if err != nil {
+		return "", err
+	}
+	if err := f.requestToken(ctx, url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}); err != nil {
+		return "", err
+	}
+	return f.accessToken, nil
+}
+
+// requestToken requests an access token from the token endpoint and caches it.
+func (f *keyFlow) requestToken(ctx context.Context, form url.Values) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("requesting access token: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading token response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("requesting access token: %w",
+			&cloud.APIError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(data))})
+	}
+	var token tokenResponse
+	if err := json.Unmarshal(data, &token); err != nil {
+		return fmt.Errorf("decoding token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return errors.New("token response does not contain an access token")
+	}
+
+	f.accessToken = token.AccessToken
+	f.refreshToken = token.RefreshToken
+	f.expiry = f.now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	return nil
+}
+
+// assertion returns a JWT signed with the service account key.
+func (f *keyFlow) assertion() (string, error) {
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+	now := f.now()
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS512",
+		"typ": "JWT",
+		"kid": f.key.Credentials.KID,
+	})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]any{
+		"iss": f.key.Credentials.Iss,
+		"sub": f.key.Credentials.Sub,
+		"aud": f.key.Credentials.Aud,
+		"jti": hex.EncodeToString(jti),
+		"iat": now.Unix(),
+		"exp": now.Add(assertionLifetime).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha512.Sum512([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, f.privateKey, crypto.SHA512, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("signing assertion: %w", err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// tokenCache shares token sources between clients, so access tokens are only
+// requested once per set of credentials. Sources are keyed by the content of
+// the credentials: rotated credentials get a new source while the ones no
+// longer used expire.
+type tokenCache struct {
+	opts Options
+
+	mu      sync.Mutex
+	sources map[string]*cachedTokenSource
+}
+
+type cachedTokenSource struct {
+	source   tokenSource
+	lastUsed time.Time
+}
+
+func newTokenCache(opts Options) *tokenCache {
+	return &tokenCache{opts: opts, sources: map[string]*cachedTokenSource{}}
+}
+
+// get returns the token source for creds.
+func (c *tokenCache) get(creds *cloud.Credentials) (tokenSource, error) {
+	h := sha256.New()
+	for _, part := range [][]byte{creds.ServiceAccountKey, creds.PrivateKey, []byte(creds.Token)} {
+		_, _ = fmt.Fprintf(h, "%d:", len(part))
+		_, _ = h.Write(part)
+	}
+	key := hex.EncodeToString(h.Sum(nil))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for k, cached := range c.sources {
+		if now.Sub(cached.lastUsed) > tokenSourceIdleTimeout {
+			delete(c.sources, k)
+		}
+	}
+	if cached, ok := c.sources[key]; ok {
+		cached.lastUsed = now
+		return cached.source, nil
+	}
+	source, err := newTokenSource(creds, c.opts)
+	if err != nil {
+		return nil, err
+	}
+	c.sources[key] = &cachedTokenSource{source: source, lastUsed: now}
+	return source, nil
+}
+
+// readCredentialsFile reads the default credentials of the controller. The
+// file holds either a service account key or a static access token.
+func readCredentialsFile(path, privateKeyPath string) (*cloud.Credentials, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading credentials file: %w", err)
+	}
+	data = bytes.TrimSpace(data)
+	if !bytes.HasPrefix(data, []byte("{")) {
+		return &cloud.Credentials{Token: string(data)}, nil
+	}
+	creds := &cloud.Credentials{ServiceAccountKey: data}
+	if privateKeyPath != "" {
+		if creds.PrivateKey, err = os.ReadFile(privateKeyPath); err != nil {
+			return nil, fmt.Errorf("reading private key file: %w", err)
+		}
+	}
+	return creds, nil
+}
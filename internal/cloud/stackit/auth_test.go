@@ -0,0 +1,251 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stackit
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+)
+
+// tokenServer is a token endpoint verifying assertions and issuing
+// numbered access tokens.
+type tokenServer struct {
+	t         *testing.T
+	publicKey *rsa.PublicKey
+	expiresIn int64
+
+	mu       sync.Mutex
+	issued   int
+	grants   []string
+	failNext bool
+}
+
+func (s *tokenServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	g := NewWithT(s.t)
+
+	g.Expect(r.ParseForm()).To(Succeed())
+	grant := r.PostForm.Get("grant_type")
+	s.grants = append(s.grants, grant)
+	switch grant {
+	case "urn:ietf:params:oauth:grant-type:jwt-bearer":
+		parts := strings.Split(r.PostForm.Get("assertion"), ".")
+		g.Expect(parts).To(HaveLen(3))
+		signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+		g.Expect(err).NotTo(HaveOccurred())
+		digest := sha512.Sum512([]byte(parts[0] + "." + parts[1]))
+		g.Expect(rsa.VerifyPKCS1v15(s.publicKey, crypto.SHA512, digest[:], signature)).To(Succeed())
+
+		var header, claims map[string]any
+		g.Expect(decodeSegment(parts[0], &header)).To(Succeed())
+		g.Expect(decodeSegment(parts[1], &claims)).To(Succeed())
+		g.Expect(header).To(HaveKeyWithValue("alg", "RS512"))
+		g.Expect(header).To(HaveKeyWithValue("kid", "key-1"))
+		g.Expect(claims).To(HaveKeyWithValue("iss", "sa@example.com"))
+		g.Expect(claims).To(HaveKeyWithValue("sub", "sa-1"))
+		g.Expect(claims).To(HaveKey("jti"))
+	case "refresh_token":
+		if s.failNext {
+			s.failNext = false
+			http.Error(w, "invalid refresh token", http.StatusBadRequest)
+			return
+		}
+		g.Expect(r.PostForm.Get("refresh_token")).To(Equal(fmt.Sprintf("refresh-%d", s.issued)))
+	default:
+		http.Error(w, "unsupported grant type", http.StatusBadRequest)
+		return
+	}
+
+	s.issued++
+	_ = json.NewEncoder(w).Encode(tokenResponse{
+		AccessToken:  fmt.Sprintf("token-%d", s.issued),
+		RefreshToken: fmt.Sprintf("refresh-%d", s.issued),
+		ExpiresIn:    s.expiresIn,
+		TokenType:    "Bearer",
+	})
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// newServiceAccountKey returns a service account key and, separately, its
+// PEM encoded private key.
+func newServiceAccountKey(t *testing.T) ([]byte, []byte, *rsa.PublicKey) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saKey, err := json.Marshal(ServiceAccountKey{
+		ID: "key-1",
+		Credentials: ServiceAccountKeyCredentials{
+			KID: "key-1",
+			Iss: "sa@example.com",
+			Sub: "sa-1",
+			Aud: "https://stackit-service-account-prod.apps.01.cf.eu01.stackit.cloud",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return saKey, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), &key.PublicKey
+}
+
+func TestKeyFlow(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	saKey, privateKey, publicKey := newServiceAccountKey(t)
+	tokens := &tokenServer{t: t, publicKey: publicKey, expiresIn: 3600}
+	server := httptest.NewServer(tokens)
+	t.Cleanup(server.Close)
+
+	source, err := newTokenSource(&cloud.Credentials{ServiceAccountKey: saKey, PrivateKey: privateKey},
+		Options{TokenEndpoint: server.URL, HTTPClient: server.Client()})
+	g.Expect(err).NotTo(HaveOccurred())
+	flow := source.(*keyFlow)
+	now := time.Now()
+	flow.now = func() time.Time { return now }
+
+	advance := func(d time.Duration) { now = now.Add(d) }
+
+	token, err := flow.token(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(token).To(Equal("token-1"))
+
+	// Cached until shortly before expiry.
+	advance(50 * time.Minute)
+	token, err = flow.token(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(token).To(Equal("token-1"))
+
+	advance(10 * time.Minute)
+	token, err = flow.token(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(token).To(Equal("token-2"))
+
+	// A rejected refresh token falls back to a new assertion.
+	tokens.failNext = true
+	advance(time.Hour)
+	token, err = flow.token(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(token).To(Equal("token-3"))
+
+	g.Expect(tokens.grants).To(Equal([]string{
+		"urn:ietf:params:oauth:grant-type:jwt-bearer",
+		"refresh_token",
+		"refresh_token",
+		"urn:ietf:params:oauth:grant-type:jwt-bearer",
+	}))
+}
+
+func TestNewTokenSourceErrors(t *testing.T) {
+	g := NewWithT(t)
+	saKey, _, _ := newServiceAccountKey(t)
+
+	_, err := newTokenSource(&cloud.Credentials{ServiceAccountKey: []byte("not json")}, Options{})
+	g.Expect(err).To(MatchError(ContainSubstring("parsing service account key")))
+	_, err = newTokenSource(&cloud.Credentials{ServiceAccountKey: saKey}, Options{})
+	g.Expect(err).To(MatchError(ContainSubstring("does not contain a private key")))
+	_, err = newTokenSource(&cloud.Credentials{ServiceAccountKey: saKey, PrivateKey: []byte("key")}, Options{})
+	g.Expect(err).To(MatchError(ContainSubstring("not PEM encoded")))
+}
+
+func TestFactoryAuthentication(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	saKey, privateKey, publicKey := newServiceAccountKey(t)
+	tokens := &tokenServer{t: t, publicKey: publicKey, expiresIn: 3600}
+	tokenEndpoint := httptest.NewServer(tokens)
+	t.Cleanup(tokenEndpoint.Close)
+
+	var mu sync.Mutex
+	var authorization []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		authorization = append(authorization, r.Header.Get("Authorization"))
+		_ = json.NewEncoder(w).Encode(ListResponse[NetworkModel]{})
+	}))
+	t.Cleanup(api.Close)
+
+	dir := t.TempDir()
+	credentialsFile := filepath.Join(dir, "credentials")
+	g.Expect(os.WriteFile(credentialsFile, []byte("static-1\n"), 0o600)).To(Succeed())
+
+	factory := NewFactory(Options{
+		IaaSEndpoint:    api.URL,
+		TokenEndpoint:   tokenEndpoint.URL,
+		CredentialsFile: credentialsFile,
+	})
+	list := func(scope cloud.Scope) {
+		t.Helper()
+		c, err := factory(ctx, scope)
+		g.Expect(err).NotTo(HaveOccurred())
+		_, err = c.Networks().List(ctx, nil)
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+	scope := cloud.Scope{ProjectID: "project", Region: "eu01"}
+
+	// The default credentials file is re-read for every client.
+	list(scope)
+	g.Expect(os.WriteFile(credentialsFile, []byte("static-2"), 0o600)).To(Succeed())
+	list(scope)
+
+	// Clients using the same service account key share its access token.
+	keyScope := scope
+	keyScope.Credentials = &cloud.Credentials{ServiceAccountKey: saKey, PrivateKey: privateKey}
+	list(keyScope)
+	list(keyScope)
+
+	g.Expect(authorization).To(Equal([]string{
+		"Bearer static-1",
+		"Bearer static-2",
+		"Bearer token-1",
+		"Bearer token-1",
+	}))
+	g.Expect(tokens.grants).To(HaveLen(1))
+}
@@ -0,0 +1,298 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package stackit implements cloud.Interface on top of the STACKIT REST APIs.
+package stackit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+)
+
+const (
+	// DefaultIaaSEndpoint is the endpoint of the STACKIT IaaS API.
+	DefaultIaaSEndpoint = "https://iaas.api.stackit.cloud"
+
+	// DefaultLoadBalancerEndpoint is the endpoint of the STACKIT Load Balancer API.
+	DefaultLoadBalancerEndpoint = "https://load-balancer.api.stackit.cloud"
+
+	// DefaultSKEEndpoint is the endpoint of the STACKIT Kubernetes Engine API.
+	DefaultSKEEndpoint = "https://ske.api.stackit.cloud"
+
+	defaultTimeout = 30 * time.Second
+)
+
+// Options configures the STACKIT API client.
+type Options struct {
+	// IaaSEndpoint is the base URL of the IaaS API. Defaults to DefaultIaaSEndpoint.
+	IaaSEndpoint string
+
+	// LoadBalancerEndpoint is the base URL of the Load Balancer API.
+	// Defaults to DefaultLoadBalancerEndpoint.
+	LoadBalancerEndpoint string
+
+	// SKEEndpoint is the base URL of the Kubernetes Engine API. Defaults to
+	// DefaultSKEEndpoint.
+	SKEEndpoint string
+
+	// TokenEndpoint is the URL access tokens are requested from with service
+	// account keys. Defaults to DefaultTokenEndpoint.
+	TokenEndpoint string
+
+	// CredentialsFile is the path of the default credentials, used for scopes
+	// without credentials. It holds either a service account key or a static
+	// access token and is read for every client, so rotated credentials are
+	// picked up without a restart. If empty, requests of such scopes are sent
+	// without authentication.
+	CredentialsFile string
+
+	// PrivateKeyFile is the path of the PEM encoded private key of the
+	// service account key in CredentialsFile, if the key does not contain it.
+	PrivateKeyFile string
+
+	// HTTPClient is the client used to send requests.
+	HTTPClient *http.Client
+}
+
+// NewFactory returns a cloud.Factory creating clients with the given options.
+// Access tokens are shared between the clients using the same credentials.
+func NewFactory(opts Options) cloud.Factory {
+	opts = opts.withDefaults()
+	tokens := newTokenCache(opts)
+	return func(_ context.Context, scope cloud.Scope) (cloud.Interface, error) {
+		creds, err := opts.credentials(scope)
+		if err != nil {
+			return nil, err
+		}
+		var source tokenSource
+		if creds != nil {
+			if source, err = tokens.get(creds); err != nil {
+				return nil, err
+			}
+		}
+		return newClient(scope, opts, source)
+	}
+}
+
+// New returns a client for the given scope.
+func New(scope cloud.Scope, opts Options) (*Client, error) {
+	opts = opts.withDefaults()
+	creds, err := opts.credentials(scope)
+	if err != nil {
+		return nil, err
+	}
+	var source tokenSource
+	if creds != nil {
+		if source, err = newTokenSource(creds, opts); err != nil {
+			return nil, err
+		}
+	}
+	return newClient(scope, opts, source)
+}
+
+func (o Options) withDefaults() Options {
+	if o.IaaSEndpoint == "" {
+		o.IaaSEndpoint = DefaultIaaSEndpoint
+	}
+	if o.LoadBalancerEndpoint == "" {
+		o.LoadBalancerEndpoint = DefaultLoadBalancerEndpoint
+	}
+	if o.SKEEndpoint == "" {
+		o.SKEEndpoint = DefaultSKEEndpoint
+	}
+	if o.TokenEndpoint == "" {
+		o.TokenEndpoint = DefaultTokenEndpoint
+	}
+	if o.HTTPClient == nil {
+		o.HTTPClient = &http.Client{Timeout: defaultTimeout}
+	}
+	return o
+}
+
+// credentials returns the credentials of scope, falling back to the default
+// credentials file.
+func (o Options) credentials(scope cloud.Scope) (*cloud.Credentials, error) {
+	if scope.Credentials != nil {
+		return scope.Credentials, nil
+	}
+	if o.CredentialsFile == "" {
+		return nil, nil
+	}
+	return readCredentialsFile(o.CredentialsFile, o.PrivateKeyFile)
+}
+
+func newClient(scope cloud.Scope, opts Options, tokens tokenSource) (*Client, error) {
+	if scope.ProjectID == "" {
+		return nil, fmt.Errorf("project ID must not be empty")
+	}
+	if scope.Region == "" {
+		return nil, fmt.Errorf("region must not be empty")
+	}
+	return &Client{
+		scope:  scope,
+		iaas:   strings.TrimSuffix(opts.IaaSEndpoint, "/"),
+		lb:     strings.TrimSuffix(opts.LoadBalancerEndpoint, "/"),
+		ske:    strings.TrimSuffix(opts.SKEEndpoint, "/"),
+		client: opts.HTTPClient,
+		tokens: tokens,
+	}, nil
+}
+
+// Client implements cloud.Interface for a single project and region.
+type Client struct {
+	scope  cloud.Scope
+	iaas   string
+	lb     string
+	ske    string
+	client *http.Client
+	tokens tokenSource
+}
+
+var _ cloud.Interface = &Client{}
+
+// Servers implements cloud.Interface.
+func (c *Client) Servers() cloud.ServerService { return &servers{c} }
+
+// Networks implements cloud.Interface.
+func (c *Client) Networks() cloud.NetworkService { return &networks{c} }
+
+// LoadBalancers implements cloud.Interface.
+func (c *Client) LoadBalancers() cloud.LoadBalancerService { return &loadBalancers{c} }
+
+// SecurityGroups implements cloud.Interface.
+func (c *Client) SecurityGroups() cloud.SecurityGroupService { return &securityGroups{c} }
+
+// Volumes implements cloud.Interface.
+func (c *Client) Volumes() cloud.VolumeService { return &volumes{c} }
+
+// Images implements cloud.Interface.
+func (c *Client) Images() cloud.ImageService { return &images{c} }
+
+// AvailabilityZones implements cloud.Interface.
+func (c *Client) AvailabilityZones() cloud.AvailabilityZoneService { return &availabilityZones{c} }
+
+// PublicIPs implements cloud.Interface.
+func (c *Client) PublicIPs() cloud.PublicIPService { return &publicIPs{c} }
+
+// ServerGroups implements cloud.Interface.
+func (c *Client) ServerGroups() cloud.ServerGroupService { return &serverGroups{c} }
+
+// ManagedClusters implements cloud.Interface.
+func (c *Client) ManagedClusters() cloud.ManagedClusterService { return &managedClusters{c} }
+
+// iaasPath returns the IaaS API URL of a project and region scoped resource.
+func (c *Client) iaasPath(format string, args ...any) string {
+	return fmt.Sprintf("%s/v2/projects/%s/regions/%s", c.iaas, url.PathEscape(c.scope.ProjectID),
+		url.PathEscape(c.scope.Region)) + escapedPath(format, args...)
+}
+
+// lbPath returns the Load Balancer API URL of a project and region scoped resource.
+func (c *Client) lbPath(format string, args ...any) string {
+	return fmt.Sprintf("%s/v2/projects/%s/regions/%s", c.lb, url.PathEscape(c.scope.ProjectID),
+		url.PathEscape(c.scope.Region)) + escapedPath(format, args...)
+}
+
+// skePath returns the Kubernetes Engine API URL of a project and region scoped resource.
+func (c *Client) skePath(format string, args ...any) string {
+	return fmt.Sprintf("%s/v2/projects/%s/regions/%s", c.ske, url.PathEscape(c.scope.ProjectID),
+		url.PathEscape(c.scope.Region)) + escapedPath(format, args...)
+}
+
+func escapedPath(format string, args ...any) string {
+	escaped := make([]any, len(args))
+	for i, arg := range args {
+		escaped[i] = url.PathEscape(fmt.Sprint(arg))
+	}
+	return fmt.Sprintf(format, escaped...)
+}
+
+// withLabelSelector appends a label selector query to u.
+func withLabelSelector(u string, labels map[string]string) string {
+	if len(labels) == 0 {
+		return u
+	}
+	selector := make([]string, 0, len(labels))
+	for _, k := range slices.Sorted(maps.Keys(labels)) {
+		selector = append(selector, k+"="+labels[k])
+	}
+	return u + "?" + url.Values{"label_selector": {strings.Join(selector, ",")}}.Encode()
+}
+
+// do sends a request with in as JSON body and decodes the response into out.
+// in and out may be nil.
+func (c *Client) do(ctx context.Context, method, u string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.tokens != nil {
+		token, err := c.tokens.token(ctx)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := &cloud.APIError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(data))}
+		var errResp ErrorResponse
+		if json.Unmarshal(data, &errResp) == nil && errResp.Message != "" {
+			apiErr.Message = errResp.Message
+		}
+		return fmt.Errorf("%s %s: %w", method, req.URL.Path, apiErr)
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
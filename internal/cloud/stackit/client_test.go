@@ -0,0 +1,138 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stackit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := New(cloud.Scope{ProjectID: "project", Region: "eu01"}, Options{
+		IaaSEndpoint:         server.URL,
+		LoadBalancerEndpoint: server.URL,
+		SKEEndpoint:          server.URL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestServersList(t *testing.T) {
+	g := NewWithT(t)
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Method).To(Equal(http.MethodGet))
+		g.Expect(r.URL.Path).To(Equal("/v2/projects/project/regions/eu01/servers"))
+		g.Expect(r.URL.Query().Get("label_selector")).To(Equal("a=1,b=2"))
+		_ = json.NewEncoder(w).Encode(ListResponse[ServerModel]{Items: []ServerModel{{
+			ID:     "server-1",
+			Name:   "machine-1",
+			Status: cloud.ServerStatusActive,
+			NICs:   []ServerNICModel{{NICID: "nic-1", NetworkID: "network-1", IPv4: "10.0.0.2"}},
+		}}})
+	})
+
+	servers, err := c.Servers().List(context.Background(), map[string]string{"b": "2", "a": "1"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(servers).To(HaveLen(1))
+	g.Expect(servers[0].ID).To(Equal("server-1"))
+	g.Expect(servers[0].NICs).To(ConsistOf(cloud.NIC{ID: "nic-1", NetworkID: "network-1", IPv4: "10.0.0.2"}))
+}
+
+func TestServersCreate(t *testing.T) {
+	g := NewWithT(t)
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Method).To(Equal(http.MethodPost))
+		var in ServerModel
+		g.Expect(json.NewDecoder(r.Body).Decode(&in)).To(Succeed())
+		g.Expect(in.BootVolume).NotTo(BeNil())
+		g.Expect(in.BootVolume.Source).To(Equal(&BootVolumeSourceModel{Type: "image", ID: "image-1"}))
+		g.Expect(in.UserData).To(Equal("I2Nsb3VkLWNvbmZpZw=="))
+		in.ID = "server-1"
+		in.Status = cloud.ServerStatusCreating
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(in)
+	})
+
+	server, err := c.Servers().Create(context.Background(), cloud.CreateServerRequest{
+		Name:        "machine-1",
+		MachineType: "c1.2",
+		ImageID:     "image-1",
+		BootVolume:  &cloud.BootVolume{Size: 50},
+		UserData:    []byte("#cloud-config"),
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(server.ID).To(Equal("server-1"))
+	g.Expect(server.ImageID).To(Equal("image-1"))
+	g.Expect(server.Status).To(Equal(cloud.ServerStatusCreating))
+}
+
+func TestErrors(t *testing.T) {
+	g := NewWithT(t)
+
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{Code: http.StatusNotFound, Message: "server not found"})
+	})
+
+	_, err := c.Servers().Get(context.Background(), "server-1")
+	g.Expect(err).To(MatchError(ContainSubstring("server not found")))
+	g.Expect(cloud.IsNotFound(err)).To(BeTrue())
+}
+
+func TestLoadBalancerUpdateSendsVersion(t *testing.T) {
+	g := NewWithT(t)
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.URL.Path).To(Equal("/v2/projects/project/regions/eu01/load-balancers/lb"))
+		switch r.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(w).Encode(LoadBalancerModel{Name: "lb", Version: "3"})
+		case http.MethodPut:
+			var in LoadBalancerModel
+			g.Expect(json.NewDecoder(r.Body).Decode(&in)).To(Succeed())
+			g.Expect(in.Version).To(Equal("3"))
+			g.Expect(in.TargetPools[0].ActiveHealthCheck.Interval).To(Equal("10s"))
+			_ = json.NewEncoder(w).Encode(in)
+		}
+	})
+
+	lb, err := c.LoadBalancers().Update(context.Background(), cloud.LoadBalancer{
+		Name: "lb",
+		TargetPools: []cloud.TargetPool{{
+			Name:        "api",
+			TargetPort:  6443,
+			HealthCheck: &cloud.HealthCheck{IntervalSeconds: 10},
+		}},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(lb.TargetPools[0].HealthCheck.IntervalSeconds).To(Equal(int32(10)))
+}
@@ -0,0 +1,512 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stackit
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+)
+
+type servers struct{ c *Client }
+
+func (s *servers) Create(ctx context.Context, req cloud.CreateServerRequest) (*cloud.Server, error) {
+	in := ServerModel{
+		Name:             req.Name,
+		MachineType:      req.MachineType,
+		AvailabilityZone: req.AvailabilityZone,
+		KeypairName:      req.KeypairName,
+		SecurityGroups:   req.SecurityGroupIDs,
+		Volumes:          req.VolumeIDs,
+		AffinityGroup:    req.ServerGroupID,
+		Labels:           req.Labels,
+	}
+	if req.BootVolume != nil {
+		in.BootVolume = &BootVolumeModel{
+			Size:                req.BootVolume.Size,
+			PerformanceClass:    req.BootVolume.PerformanceClass,
+			DeleteOnTermination: req.BootVolume.DeleteOnTermination,
+			Source:              &BootVolumeSourceModel{Type: "image", ID: req.ImageID},
+		}
+	} else {
+		in.ImageID = req.ImageID
+	}
+	if req.NetworkID != "" {
+		in.Networking = &NetworkingModel{NetworkID: req.NetworkID}
+	}
+	if len(req.UserData) > 0 {
+		in.UserData = base64.StdEncoding.EncodeToString(req.UserData)
+	}
+
+	var out ServerModel
+	if err := s.c.do(ctx, http.MethodPost, s.c.iaasPath("/servers"), in, &out); err != nil {
+		return nil, err
+	}
+	return serverFromModel(&out), nil
+}
+
+func (s *servers) Get(ctx context.Context, id string) (*cloud.Server, error) {
+	var out ServerModel
+	if err := s.c.do(ctx, http.MethodGet, s.c.iaasPath("/servers/%s", id), nil, &out); err != nil {
+		return nil, err
+	}
+	return serverFromModel(&out), nil
+}
+
+func (s *servers) List(ctx context.Context, labels map[string]string) ([]cloud.Server, error) {
+	var out ListResponse[ServerModel]
+	if err := s.c.do(ctx, http.MethodGet, withLabelSelector(s.c.iaasPath("/servers"), labels), nil, &out); err != nil {
+		return nil, err
+	}
+	result := make([]cloud.Server, 0, len(out.Items))
+	for i := range out.Items {
+		result = append(result, *serverFromModel(&out.Items[i]))
+	}
+	return result, nil
+}
+
+func (s *servers) Delete(ctx context.Context, id string) error {
+	return s.c.do(ctx, http.MethodDelete, s.c.iaasPath("/servers/%s", id), nil, nil)
+}
+
+func serverFromModel(m *ServerModel) *cloud.Server {
+	server := &cloud.Server{
+		ID:               m.ID,
+		Name:             m.Name,
+		MachineType:      m.MachineType,
+		ImageID:          m.ImageID,
+		AvailabilityZone: m.AvailabilityZone,
+		Status:           m.Status,
+		ErrorMessage:     m.ErrorMessage,
+		Labels:           m.Labels,
+		VolumeIDs:        m.Volumes,
+		ServerGroupID:    m.AffinityGroup,
+	}
+	if server.ImageID == "" && m.BootVolume != nil && m.BootVolume.Source != nil {
+		server.ImageID = m.BootVolume.Source.ID
+	}
+	for _, nic := range m.NICs {
+		server.NICs = append(server.NICs, cloud.NIC{
+			ID:        nic.NICID,
+			NetworkID: nic.NetworkID,
+			IPv4:      nic.IPv4,
+			PublicIP:  nic.PublicIP,
+		})
+	}
+	return server
+}
+
+type networks struct{ c *Client }
+
+func (n *networks) Create(ctx context.Context, req cloud.CreateNetworkRequest) (*cloud.Network, error) {
+	in := CreateNetworkPayload{
+		Name:   req.Name,
+		Routed: req.Routed,
+		Labels: req.Labels,
+	}
+	if req.IPv4Prefix != "" || len(req.NameServers) > 0 {
+		in.AddressFamily = &NetworkAddressFamilyModel{
+			IPv4: &NetworkIPv4Model{Prefix: req.IPv4Prefix, NameServers: req.NameServers},
+		}
+	}
+
+	var out NetworkModel
+	if err := n.c.do(ctx, http.MethodPost, n.c.iaasPath("/networks"), in, &out); err != nil {
+		return nil, err
+	}
+	return networkFromModel(&out), nil
+}
+
+func (n *networks) Get(ctx context.Context, id string) (*cloud.Network, error) {
+	var out NetworkModel
+	if err := n.c.do(ctx, http.MethodGet, n.c.iaasPath("/networks/%s", id), nil, &out); err != nil {
+		return nil, err
+	}
+	return networkFromModel(&out), nil
+}
+
+func (n *networks) List(ctx context.Context, labels map[string]string) ([]cloud.Network, error) {
+	var out ListResponse[NetworkModel]
+	if err := n.c.do(ctx, http.MethodGet, withLabelSelector(n.c.iaasPath("/networks"), labels), nil, &out); err != nil {
+		return nil, err
+	}
+	result := make([]cloud.Network, 0, len(out.Items))
+	for i := range out.Items {
+		result = append(result, *networkFromModel(&out.Items[i]))
+	}
+	return result, nil
+}
+
+func (n *networks) Delete(ctx context.Context, id string) error {
+	return n.c.do(ctx, http.MethodDelete, n.c.iaasPath("/networks/%s", id), nil, nil)
+}
+
+func networkFromModel(m *NetworkModel) *cloud.Network {
+	return &cloud.Network{
+		ID:          m.NetworkID,
+		Name:        m.Name,
+		Prefixes:    m.Prefixes,
+		NameServers: m.NameServers,
+		Routed:      m.Routed,
+		PublicIP:    m.PublicIP,
+		Status:      m.State,
+		Labels:      m.Labels,
+	}
+}
+
+type securityGroups struct{ c *Client }
+
+func (s *securityGroups) Create(
+	ctx context.Context, req cloud.CreateSecurityGroupRequest,
+) (*cloud.SecurityGroup, error) {
+	in := SecurityGroupModel{
+		Name:        req.Name,
+		Description: req.Description,
+		Stateful:    req.Stateful,
+		Labels:      req.Labels,
+	}
+	var out SecurityGroupModel
+	if err := s.c.do(ctx, http.MethodPost, s.c.iaasPath("/security-groups"), in, &out); err != nil {
+		return nil, err
+	}
+	return securityGroupFromModel(&out), nil
+}
+
+func (s *securityGroups) Get(ctx context.Context, id string) (*cloud.SecurityGroup, error) {
+	var out SecurityGroupModel
+	if err := s.c.do(ctx, http.MethodGet, s.c.iaasPath("/security-groups/%s", id), nil, &out); err != nil {
+		return nil, err
+	}
+	return securityGroupFromModel(&out), nil
+}
+
+func (s *securityGroups) List(ctx context.Context, labels map[string]string) ([]cloud.SecurityGroup, error) {
+	var out ListResponse[SecurityGroupModel]
+	u := withLabelSelector(s.c.iaasPath("/security-groups"), labels)
+	if err := s.c.do(ctx, http.MethodGet, u, nil, &out); err != nil {
+		return nil, err
+	}
+	result := make([]cloud.SecurityGroup, 0, len(out.Items))
+	for i := range out.Items {
+		result = append(result, *securityGroupFromModel(&out.Items[i]))
+	}
+	return result, nil
+}
+
+func (s *securityGroups) Delete(ctx context.Context, id string) error {
+	return s.c.do(ctx, http.MethodDelete, s.c.iaasPath("/security-groups/%s", id), nil, nil)
+}
+
+func (s *securityGroups) CreateRule(
+	ctx context.Context, groupID string, rule cloud.SecurityGroupRule,
+) (*cloud.SecurityGroupRule, error) {
+	in := securityGroupRuleToModel(rule)
+	var out SecurityGroupRuleModel
+	u := s.c.iaasPath("/security-groups/%s/rules", groupID)
+	if err := s.c.do(ctx, http.MethodPost, u, in, &out); err != nil {
+		return nil, err
+	}
+	created := securityGroupRuleFromModel(out)
+	return &created, nil
+}
+
+func (s *securityGroups) DeleteRule(ctx context.Context, groupID, ruleID string) error {
+	return s.c.do(ctx, http.MethodDelete, s.c.iaasPath("/security-groups/%s/rules/%s", groupID, ruleID), nil, nil)
+}
+
+func securityGroupFromModel(m *SecurityGroupModel) *cloud.SecurityGroup {
+	group := &cloud.SecurityGroup{
+		ID:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		Stateful:    m.Stateful,
+		Labels:      m.Labels,
+	}
+	for _, rule := range m.Rules {
+		group.Rules = append(group.Rules, securityGroupRuleFromModel(rule))
+	}
+	return group
+}
+
+func securityGroupRuleToModel(rule cloud.SecurityGroupRule) SecurityGroupRuleModel {
+	m := SecurityGroupRuleModel{
+		ID:                    rule.ID,
+		Description:           rule.Description,
+		Direction:             rule.Direction,
+		EtherType:             "IPv4",
+		IPRange:               rule.RemoteIPPrefix,
+		RemoteSecurityGroupID: rule.RemoteSecurityGroupID,
+	}
+	if rule.Protocol != "" {
+		m.Protocol = &ProtocolModel{Name: rule.Protocol}
+	}
+	if rule.PortRangeMin != 0 || rule.PortRangeMax != 0 {
+		m.PortRange = &PortRangeModel{Min: rule.PortRangeMin, Max: rule.PortRangeMax}
+	}
+	return m
+}
+
+func securityGroupRuleFromModel(m SecurityGroupRuleModel) cloud.SecurityGroupRule {
+	rule := cloud.SecurityGroupRule{
+		ID:                    m.ID,
+		Description:           m.Description,
+		Direction:             m.Direction,
+		RemoteIPPrefix:        m.IPRange,
+		RemoteSecurityGroupID: m.RemoteSecurityGroupID,
+	}
+	if m.Protocol != nil {
+		rule.Protocol = m.Protocol.Name
+	}
+	if m.PortRange != nil {
+		rule.PortRangeMin = m.PortRange.Min
+		rule.PortRangeMax = m.PortRange.Max
+	}
+	return rule
+}
+
+type volumes struct{ c *Client }
+
+func (v *volumes) Create(ctx context.Context, req cloud.CreateVolumeRequest) (*cloud.Volume, error) {
+	in := VolumeModel{
+		Name:             req.Name,
+		Size:             req.Size,
+		PerformanceClass: req.PerformanceClass,
+		AvailabilityZone: req.AvailabilityZone,
+		Labels:           req.Labels,
+	}
+	if e := req.Encryption; e != nil {
+		in.EncryptionParameters = &VolumeEncryptionParametersModel{
+			KekKeyringID:   e.KeyRingID,
+			KekKeyID:       e.KeyID,
+			KekKeyVersion:  e.KeyVersion,
+			ServiceAccount: e.ServiceAccount,
+		}
+	}
+	var out VolumeModel
+	if err := v.c.do(ctx, http.MethodPost, v.c.iaasPath("/volumes"), in, &out); err != nil {
+		return nil, err
+	}
+	return volumeFromModel(&out), nil
+}
+
+func (v *volumes) Get(ctx context.Context, id string) (*cloud.Volume, error) {
+	var out VolumeModel
+	if err := v.c.do(ctx, http.MethodGet, v.c.iaasPath("/volumes/%s", id), nil, &out); err != nil {
+		return nil, err
+	}
+	return volumeFromModel(&out), nil
+}
+
+func (v *volumes) List(ctx context.Context, labels map[string]string) ([]cloud.Volume, error) {
+	var out ListResponse[VolumeModel]
+	if err := v.c.do(ctx, http.MethodGet, withLabelSelector(v.c.iaasPath("/volumes"), labels), nil, &out); err != nil {
+		return nil, err
+	}
+	result := make([]cloud.Volume, 0, len(out.Items))
+	for i := range out.Items {
+		result = append(result, *volumeFromModel(&out.Items[i]))
+	}
+	return result, nil
+}
+
+func (v *volumes) Delete(ctx context.Context, id string) error {
+	return v.c.do(ctx, http.MethodDelete, v.c.iaasPath("/volumes/%s", id), nil, nil)
+}
+
+func (v *volumes) Attach(ctx context.Context, serverID, volumeID string) error {
+	u := v.c.iaasPath("/servers/%s/volume-attachments/%s", serverID, volumeID)
+	return v.c.do(ctx, http.MethodPut, u, struct{}{}, nil)
+}
+
+func (v *volumes) Detach(ctx context.Context, serverID, volumeID string) error {
+	return v.c.do(ctx, http.MethodDelete, v.c.iaasPath("/servers/%s/volume-attachments/%s", serverID, volumeID), nil, nil)
+}
+
+func (v *volumes) UpdateLabels(ctx context.Context, id string, labels map[string]string) (*cloud.Volume, error) {
+	// An empty object removes all labels, null keeps them.
+	in := UpdateVolumeModel{Labels: map[string]string{}}
+	maps.Copy(in.Labels, labels)
+	var out VolumeModel
+	if err := v.c.do(ctx, http.MethodPatch, v.c.iaasPath("/volumes/%s", id), in, &out); err != nil {
+		return nil, err
+	}
+	return volumeFromModel(&out), nil
+}
+
+func volumeFromModel(m *VolumeModel) *cloud.Volume {
+	return &cloud.Volume{
+		ID:               m.ID,
+		Name:             m.Name,
+		Size:             m.Size,
+		PerformanceClass: m.PerformanceClass,
+		AvailabilityZone: m.AvailabilityZone,
+		Status:           m.Status,
+		ServerID:         m.ServerID,
+		Encrypted:        m.Encrypted,
+		Labels:           m.Labels,
+	}
+}
+
+type images struct{ c *Client }
+
+func (i *images) Get(ctx context.Context, id string) (*cloud.Image, error) {
+	var out ImageModel
+	if err := i.c.do(ctx, http.MethodGet, i.c.iaasPath("/images/%s", id), nil, &out); err != nil {
+		return nil, err
+	}
+	return imageFromModel(&out), nil
+}
+
+func (i *images) List(ctx context.Context) ([]cloud.Image, error) {
+	var out ListResponse[ImageModel]
+	if err := i.c.do(ctx, http.MethodGet, i.c.iaasPath("/images"), nil, &out); err != nil {
+		return nil, err
+	}
+	result := make([]cloud.Image, 0, len(out.Items))
+	for j := range out.Items {
+		result = append(result, *imageFromModel(&out.Items[j]))
+	}
+	return result, nil
+}
+
+func imageFromModel(m *ImageModel) *cloud.Image {
+	image := &cloud.Image{
+		ID:     m.ID,
+		Name:   m.Name,
+		Status: m.Status,
+		Labels: m.Labels,
+	}
+	if m.Config != nil {
+		image.OSDistro = m.Config.OperatingSystemDistro
+		image.OSVersion = m.Config.OperatingSystemVersion
+	}
+	if m.CreatedAt != nil {
+		image.CreatedAt = *m.CreatedAt
+	}
+	return image
+}
+
+type publicIPs struct{ c *Client }
+
+func (p *publicIPs) Create(ctx context.Context, req cloud.CreatePublicIPRequest) (*cloud.PublicIP, error) {
+	var out PublicIPModel
+	if err := p.c.do(ctx, http.MethodPost, p.c.iaasPath("/public-ips"), PublicIPModel{Labels: req.Labels}, &out); err != nil {
+		return nil, err
+	}
+	return publicIPFromModel(&out), nil
+}
+
+func (p *publicIPs) Get(ctx context.Context, id string) (*cloud.PublicIP, error) {
+	var out PublicIPModel
+	if err := p.c.do(ctx, http.MethodGet, p.c.iaasPath("/public-ips/%s", id), nil, &out); err != nil {
+		return nil, err
+	}
+	return publicIPFromModel(&out), nil
+}
+
+func (p *publicIPs) List(ctx context.Context, labels map[string]string) ([]cloud.PublicIP, error) {
+	var out ListResponse[PublicIPModel]
+	if err := p.c.do(ctx, http.MethodGet, withLabelSelector(p.c.iaasPath("/public-ips"), labels), nil, &out); err != nil {
+		return nil, err
+	}
+	result := make([]cloud.PublicIP, 0, len(out.Items))
+	for i := range out.Items {
+		result = append(result, *publicIPFromModel(&out.Items[i]))
+	}
+	return result, nil
+}
+
+func (p *publicIPs) Delete(ctx context.Context, id string) error {
+	return p.c.do(ctx, http.MethodDelete, p.c.iaasPath("/public-ips/%s", id), nil, nil)
+}
+
+func (p *publicIPs) Associate(ctx context.Context, serverID, id string) error {
+	return p.c.do(ctx, http.MethodPut, p.c.iaasPath("/servers/%s/public-ips/%s", serverID, id), struct{}{}, nil)
+}
+
+func (p *publicIPs) Disassociate(ctx context.Context, serverID, id string) error {
+	return p.c.do(ctx, http.MethodDelete, p.c.iaasPath("/servers/%s/public-ips/%s", serverID, id), nil, nil)
+}
+
+func publicIPFromModel(m *PublicIPModel) *cloud.PublicIP {
+	return &cloud.PublicIP{
+		ID:               m.ID,
+		IP:               m.IP,
+		NetworkInterface: m.NetworkInterface,
+		Labels:           m.Labels,
+	}
+}
+
+type serverGroups struct{ c *Client }
+
+func (g *serverGroups) Create(ctx context.Context, req cloud.CreateServerGroupRequest) (*cloud.ServerGroup, error) {
+	var out ServerGroupModel
+	in := ServerGroupModel{Name: req.Name, Policy: req.Policy}
+	if err := g.c.do(ctx, http.MethodPost, g.c.iaasPath("/server-groups"), in, &out); err != nil {
+		return nil, err
+	}
+	return serverGroupFromModel(&out), nil
+}
+
+func (g *serverGroups) Get(ctx context.Context, id string) (*cloud.ServerGroup, error) {
+	var out ServerGroupModel
+	if err := g.c.do(ctx, http.MethodGet, g.c.iaasPath("/server-groups/%s", id), nil, &out); err != nil {
+		return nil, err
+	}
+	return serverGroupFromModel(&out), nil
+}
+
+func (g *serverGroups) List(ctx context.Context) ([]cloud.ServerGroup, error) {
+	var out ListResponse[ServerGroupModel]
+	if err := g.c.do(ctx, http.MethodGet, g.c.iaasPath("/server-groups"), nil, &out); err != nil {
+		return nil, err
+	}
+	result := make([]cloud.ServerGroup, 0, len(out.Items))
+	for i := range out.Items {
+		result = append(result, *serverGroupFromModel(&out.Items[i]))
+	}
+	return result, nil
+}
+
+func (g *serverGroups) Delete(ctx context.Context, id string) error {
+	return g.c.do(ctx, http.MethodDelete, g.c.iaasPath("/server-groups/%s", id), nil, nil)
+}
+
+func serverGroupFromModel(m *ServerGroupModel) *cloud.ServerGroup {
+	return &cloud.ServerGroup{
+		ID:        m.ID,
+		Name:      m.Name,
+		Policy:    m.Policy,
+		MemberIDs: m.Members,
+	}
+}
+
+type availabilityZones struct{ c *Client }
+
+func (a *availabilityZones) List(ctx context.Context) ([]string, error) {
+	u := fmt.Sprintf("%s/v2/regions/%s/availability-zones", a.c.iaas, url.PathEscape(a.c.scope.Region))
+	var out ListResponse[string]
+	if err := a.c.do(ctx, http.MethodGet, u, nil, &out); err != nil {
+		return nil, err
+	}
+	return out.Items, nil
+}
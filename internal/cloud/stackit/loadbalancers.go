@@ -0,0 +1,185 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stackit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+)
+
+const (
+	protocolTCP = "PROTOCOL_TCP"
+
+	networkRoleListenersAndTargets = "ROLE_LISTENERS_AND_TARGETS"
+)
+
+type loadBalancers struct{ c *Client }
+
+func (l *loadBalancers) Create(ctx context.Context, lb cloud.LoadBalancer) (*cloud.LoadBalancer, error) {
+	var out LoadBalancerModel
+	if err := l.c.do(ctx, http.MethodPost, l.c.lbPath("/load-balancers"), loadBalancerToModel(&lb), &out); err != nil {
+		return nil, err
+	}
+	return loadBalancerFromModel(&out), nil
+}
+
+func (l *loadBalancers) Get(ctx context.Context, name string) (*cloud.LoadBalancer, error) {
+	var out LoadBalancerModel
+	if err := l.c.do(ctx, http.MethodGet, l.c.lbPath("/load-balancers/%s", name), nil, &out); err != nil {
+		return nil, err
+	}
+	return loadBalancerFromModel(&out), nil
+}
+
+// Update replaces the configuration of a load balancer. The API requires the
+// current version of the load balancer, which is fetched first.
+func (l *loadBalancers) Update(ctx context.Context, lb cloud.LoadBalancer) (*cloud.LoadBalancer, error) {
+	u := l.c.lbPath("/load-balancers/%s", lb.Name)
+
+	var current LoadBalancerModel
+	if err := l.c.do(ctx, http.MethodGet, u, nil, &current); err != nil {
+		return nil, err
+	}
+	in := loadBalancerToModel(&lb)
+	in.Version = current.Version
+
+	var out LoadBalancerModel
+	if err := l.c.do(ctx, http.MethodPut, u, in, &out); err != nil {
+		return nil, err
+	}
+	return loadBalancerFromModel(&out), nil
+}
+
+func (l *loadBalancers) Delete(ctx context.Context, name string) error {
+	return l.c.do(ctx, http.MethodDelete, l.c.lbPath("/load-balancers/%s", name), nil, nil)
+}
+
+func loadBalancerToModel(lb *cloud.LoadBalancer) *LoadBalancerModel {
+	m := &LoadBalancerModel{
+		Name:   lb.Name,
+		Labels: lb.Labels,
+	}
+	for _, listener := range lb.Listeners {
+		protocol := listener.Protocol
+		if protocol == "" {
+			protocol = protocolTCP
+		}
+		m.Listeners = append(m.Listeners, ListenerModel{
+			DisplayName: listener.Name,
+			Port:        listener.Port,
+			Protocol:    protocol,
+			TargetPool:  listener.TargetPool,
+		})
+	}
+	for _, pool := range lb.TargetPools {
+		pm := TargetPoolModel{
+			Name:       pool.Name,
+			TargetPort: pool.TargetPort,
+		}
+		for _, target := range pool.Targets {
+			pm.Targets = append(pm.Targets, TargetModel{DisplayName: target.DisplayName, IP: target.IP})
+		}
+		if hc := pool.HealthCheck; hc != nil {
+			pm.ActiveHealthCheck = &HealthCheckModel{
+				Interval:           formatSeconds(hc.IntervalSeconds),
+				Timeout:            formatSeconds(hc.TimeoutSeconds),
+				HealthyThreshold:   hc.HealthyThreshold,
+				UnhealthyThreshold: hc.UnhealthyThreshold,
+			}
+		}
+		m.TargetPools = append(m.TargetPools, pm)
+	}
+	if lb.NetworkID != "" {
+		m.Networks = []LoadBalancerNetworkModel{{NetworkID: lb.NetworkID, Role: networkRoleListenersAndTargets}}
+	}
+	if lb.Options.PrivateNetworkOnly || len(lb.Options.AllowedSourceRanges) > 0 {
+		m.Options = &LoadBalancerOptionsModel{PrivateNetworkOnly: lb.Options.PrivateNetworkOnly}
+		if len(lb.Options.AllowedSourceRanges) > 0 {
+			m.Options.AccessControl = &AccessControlModel{AllowedSourceRanges: lb.Options.AllowedSourceRanges}
+		}
+	}
+	return m
+}
+
+func loadBalancerFromModel(m *LoadBalancerModel) *cloud.LoadBalancer {
+	lb := &cloud.LoadBalancer{
+		Name:            m.Name,
+		ExternalAddress: m.ExternalAddress,
+		PrivateAddress:  m.PrivateAddress,
+		Status:          m.Status,
+		Labels:          m.Labels,
+	}
+	for _, listener := range m.Listeners {
+		lb.Listeners = append(lb.Listeners, cloud.Listener{
+			Name:       listener.DisplayName,
+			Port:       listener.Port,
+			Protocol:   listener.Protocol,
+			TargetPool: listener.TargetPool,
+		})
+	}
+	for _, pm := range m.TargetPools {
+		pool := cloud.TargetPool{
+			Name:       pm.Name,
+			TargetPort: pm.TargetPort,
+		}
+		for _, target := range pm.Targets {
+			pool.Targets = append(pool.Targets, cloud.Target{DisplayName: target.DisplayName, IP: target.IP})
+		}
+		if hc := pm.ActiveHealthCheck; hc != nil {
+			pool.HealthCheck = &cloud.HealthCheck{
+				IntervalSeconds:    parseSeconds(hc.Interval),
+				TimeoutSeconds:     parseSeconds(hc.Timeout),
+				HealthyThreshold:   hc.HealthyThreshold,
+				UnhealthyThreshold: hc.UnhealthyThreshold,
+			}
+		}
+		lb.TargetPools = append(lb.TargetPools, pool)
+	}
+	if len(m.Networks) > 0 {
+		lb.NetworkID = m.Networks[0].NetworkID
+	}
+	if m.Options != nil {
+		lb.Options.PrivateNetworkOnly = m.Options.PrivateNetworkOnly
+		if m.Options.AccessControl != nil {
+			lb.Options.AllowedSourceRanges = m.Options.AccessControl.AllowedSourceRanges
+		}
+	}
+	for _, e := range m.Errors {
+		lb.Errors = append(lb.Errors, fmt.Sprintf("%s: %s", e.Type, e.Description))
+	}
+	return lb
+}
+
+func formatSeconds(s int32) string {
+	if s == 0 {
+		return ""
+	}
+	return strconv.Itoa(int(s)) + "s"
+}
+
+func parseSeconds(s string) int32 {
+	n, err := strconv.Atoi(strings.TrimSuffix(s, "s"))
+	if err != nil {
+		return 0
+	}
+	return int32(n)
+}
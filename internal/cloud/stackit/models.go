@@ -0,0 +1,387 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stackit
+
+import "time"
+
+// The types in this file are the JSON representations used by the STACKIT
+// IaaS, Load Balancer and Kubernetes Engine APIs. Only the fields used by
+// the provider are modelled.
+
+// ErrorResponse is the body of an unsuccessful response.
+type ErrorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"msg"`
+}
+
+// ListResponse is the body of a list response.
+type ListResponse[T any] struct {
+	Items []T `json:"items"`
+}
+
+// ServerModel is a server of the IaaS API.
+type ServerModel struct {
+	ID               string            `json:"id,omitempty"`
+	Name             string            `json:"name"`
+	MachineType      string            `json:"machineType"`
+	ImageID          string            `json:"imageId,omitempty"`
+	AvailabilityZone string            `json:"availabilityZone,omitempty"`
+	KeypairName      string            `json:"keypairName,omitempty"`
+	BootVolume       *BootVolumeModel  `json:"bootVolume,omitempty"`
+	Networking       *NetworkingModel  `json:"networking,omitempty"`
+	SecurityGroups   []string          `json:"securityGroups,omitempty"`
+	UserData         string            `json:"userData,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	Status           string            `json:"status,omitempty"`
+	ErrorMessage     string            `json:"errorMessage,omitempty"`
+	NICs             []ServerNICModel  `json:"nics,omitempty"`
+	Volumes          []string          `json:"volumes,omitempty"`
+	AffinityGroup    string            `json:"affinityGroup,omitempty"`
+}
+
+// BootVolumeModel configures the boot volume of a server.
+type BootVolumeModel struct {
+	Size                int64                  `json:"size,omitempty"`
+	PerformanceClass    string                 `json:"performanceClass,omitempty"`
+	DeleteOnTermination bool                   `json:"deleteOnTermination,omitempty"`
+	Source              *BootVolumeSourceModel `json:"source,omitempty"`
+}
+
+// BootVolumeSourceModel is the source a boot volume is created from.
+type BootVolumeSourceModel struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// NetworkingModel attaches a new server to a network.
+type NetworkingModel struct {
+	NetworkID string `json:"networkId"`
+}
+
+// ServerNICModel is a network interface of a server.
+type ServerNICModel struct {
+	NICID     string `json:"nicId"`
+	NetworkID string `json:"networkId"`
+	IPv4      string `json:"ipv4,omitempty"`
+	PublicIP  string `json:"publicIp,omitempty"`
+}
+
+// NetworkModel is a network of the IaaS API.
+type NetworkModel struct {
+	NetworkID   string            `json:"networkId,omitempty"`
+	Name        string            `json:"name"`
+	Prefixes    []string          `json:"prefixes,omitempty"`
+	NameServers []string          `json:"nameservers,omitempty"`
+	Routed      bool              `json:"routed"`
+	PublicIP    string            `json:"publicIp,omitempty"`
+	State       string            `json:"state,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+// CreateNetworkPayload is the body of a network create request.
+type CreateNetworkPayload struct {
+	Name          string                     `json:"name"`
+	AddressFamily *NetworkAddressFamilyModel `json:"addressFamily,omitempty"`
+	Routed        bool                       `json:"routed"`
+	Labels        map[string]string          `json:"labels,omitempty"`
+}
+
+// NetworkAddressFamilyModel configures the address families of a network.
+type NetworkAddressFamilyModel struct {
+	IPv4 *NetworkIPv4Model `json:"ipv4,omitempty"`
+}
+
+// NetworkIPv4Model configures the IPv4 address family of a network.
+type NetworkIPv4Model struct {
+	Prefix      string   `json:"prefix,omitempty"`
+	NameServers []string `json:"nameservers,omitempty"`
+}
+
+// SecurityGroupModel is a security group of the IaaS API.
+type SecurityGroupModel struct {
+	ID          string                   `json:"id,omitempty"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description,omitempty"`
+	Stateful    bool                     `json:"stateful"`
+	Labels      map[string]string        `json:"labels,omitempty"`
+	Rules       []SecurityGroupRuleModel `json:"rules,omitempty"`
+}
+
+// SecurityGroupRuleModel is a rule of a security group.
+type SecurityGroupRuleModel struct {
+	ID                    string          `json:"id,omitempty"`
+	Description           string          `json:"description,omitempty"`
+	Direction             string          `json:"direction"`
+	EtherType             string          `json:"ethertype,omitempty"`
+	Protocol              *ProtocolModel  `json:"protocol,omitempty"`
+	PortRange             *PortRangeModel `json:"portRange,omitempty"`
+	IPRange               string          `json:"ipRange,omitempty"`
+	RemoteSecurityGroupID string          `json:"remoteSecurityGroupId,omitempty"`
+}
+
+// ProtocolModel is the protocol a security group rule matches.
+type ProtocolModel struct {
+	Name string `json:"name"`
+}
+
+// PortRangeModel is the port range a security group rule matches.
+type PortRangeModel struct {
+	Min int32 `json:"min"`
+	Max int32 `json:"max"`
+}
+
+// VolumeModel is a volume of the IaaS API.
+type VolumeModel struct {
+	ID               string            `json:"id,omitempty"`
+	Name             string            `json:"name,omitempty"`
+	Size             int64             `json:"size"`
+	PerformanceClass string            `json:"performanceClass,omitempty"`
+	AvailabilityZone string            `json:"availabilityZone"`
+	Status           string            `json:"status,omitempty"`
+	ServerID         string            `json:"serverId,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty"`
+
+	Encrypted            bool                             `json:"encrypted,omitempty"`
+	EncryptionParameters *VolumeEncryptionParametersModel `json:"encryptionParameters,omitempty"`
+}
+
+// UpdateVolumeModel is the request body updating a volume.
+type UpdateVolumeModel struct {
+	Labels map[string]string `json:"labels"`
+}
+
+// VolumeEncryptionParametersModel configures the encryption of a new volume.
+type VolumeEncryptionParametersModel struct {
+	KekKeyringID   string `json:"kekKeyringId"`
+	KekKeyID       string `json:"kekKeyId"`
+	KekKeyVersion  int64  `json:"kekKeyVersion"`
+	ServiceAccount string `json:"serviceAccount"`
+}
+
+// ImageModel is an image of the IaaS API.
+type ImageModel struct {
+	ID        string            `json:"id"`
+	Name      string            `json:"name"`
+	Status    string            `json:"status,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Config    *ImageConfigModel `json:"config,omitempty"`
+	CreatedAt *time.Time        `json:"createdAt,omitempty"`
+}
+
+// ImageConfigModel describes the operating system of an image.
+type ImageConfigModel struct {
+	OperatingSystemDistro  string `json:"operatingSystemDistro,omitempty"`
+	OperatingSystemVersion string `json:"operatingSystemVersion,omitempty"`
+}
+
+// LoadBalancerModel is a load balancer of the Load Balancer API.
+type LoadBalancerModel struct {
+	Name            string                     `json:"name"`
+	ExternalAddress string                     `json:"externalAddress,omitempty"`
+	PrivateAddress  string                     `json:"privateAddress,omitempty"`
+	Listeners       []ListenerModel            `json:"listeners,omitempty"`
+	TargetPools     []TargetPoolModel          `json:"targetPools,omitempty"`
+	Networks        []LoadBalancerNetworkModel `json:"networks,omitempty"`
+	Options         *LoadBalancerOptionsModel  `json:"options,omitempty"`
+	Status          string                     `json:"status,omitempty"`
+	Errors          []LoadBalancerErrorModel   `json:"errors,omitempty"`
+	Version         string                     `json:"version,omitempty"`
+	Labels          map[string]string          `json:"labels,omitempty"`
+}
+
+// ListenerModel is a listener of a load balancer.
+type ListenerModel struct {
+	DisplayName string `json:"displayName,omitempty"`
+	Port        int32  `json:"port"`
+	Protocol    string `json:"protocol"`
+	TargetPool  string `json:"targetPool"`
+}
+
+// TargetPoolModel is a target pool of a load balancer.
+type TargetPoolModel struct {
+	Name              string            `json:"name"`
+	TargetPort        int32             `json:"targetPort"`
+	Targets           []TargetModel     `json:"targets,omitempty"`
+	ActiveHealthCheck *HealthCheckModel `json:"activeHealthCheck,omitempty"`
+}
+
+// TargetModel is a target of a target pool.
+type TargetModel struct {
+	DisplayName string `json:"displayName"`
+	IP          string `json:"ip"`
+}
+
+// HealthCheckModel configures the active health check of a target pool.
+// Durations are formatted like "10s".
+type HealthCheckModel struct {
+	Interval           string `json:"interval,omitempty"`
+	Timeout            string `json:"timeout,omitempty"`
+	HealthyThreshold   int32  `json:"healthyThreshold,omitempty"`
+	UnhealthyThreshold int32  `json:"unhealthyThreshold,omitempty"`
+}
+
+// LoadBalancerNetworkModel attaches a load balancer to a network.
+type LoadBalancerNetworkModel struct {
+	NetworkID string `json:"networkId"`
+	Role      string `json:"role"`
+}
+
+// LoadBalancerOptionsModel configures optional load balancer features.
+type LoadBalancerOptionsModel struct {
+	PrivateNetworkOnly bool                `json:"privateNetworkOnly,omitempty"`
+	AccessControl      *AccessControlModel `json:"accessControl,omitempty"`
+}
+
+// AccessControlModel restricts the source ranges allowed to connect.
+type AccessControlModel struct {
+	AllowedSourceRanges []string `json:"allowedSourceRanges,omitempty"`
+}
+
+// LoadBalancerErrorModel describes why a load balancer is not ready.
+type LoadBalancerErrorModel struct {
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+// PublicIPModel is a public IP of the IaaS API.
+type PublicIPModel struct {
+	ID               string            `json:"id,omitempty"`
+	IP               string            `json:"ip,omitempty"`
+	NetworkInterface string            `json:"networkInterface,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty"`
+}
+
+// ServerGroupModel is a server group of the IaaS API.
+type ServerGroupModel struct {
+	ID      string   `json:"id,omitempty"`
+	Name    string   `json:"name"`
+	Policy  string   `json:"policy"`
+	Members []string `json:"members,omitempty"`
+}
+
+// ProjectModel is a project of the Resource Manager API.
+type ProjectModel struct {
+	ProjectID      string            `json:"projectId"`
+	Name           string            `json:"name"`
+	LifecycleState string            `json:"lifecycleState"`
+	Labels         map[string]string `json:"labels,omitempty"`
+}
+
+// SKEClusterModel is a cluster of the Kubernetes Engine API. The name is
+// part of the URL and only set in responses.
+type SKEClusterModel struct {
+	Name        string                 `json:"name,omitempty"`
+	Kubernetes  SKEKubernetesModel     `json:"kubernetes"`
+	Nodepools   []SKENodepoolModel     `json:"nodepools"`
+	Maintenance *SKEMaintenanceModel   `json:"maintenance,omitempty"`
+	Network     *SKENetworkModel       `json:"network,omitempty"`
+	Status      *SKEClusterStatusModel `json:"status,omitempty"`
+}
+
+// SKEKubernetesModel configures the Kubernetes version of a cluster.
+type SKEKubernetesModel struct {
+	Version string `json:"version"`
+}
+
+// SKENodepoolModel is a node pool of a cluster.
+type SKENodepoolModel struct {
+	Name              string            `json:"name"`
+	Machine           SKEMachineModel   `json:"machine"`
+	Minimum           int32             `json:"minimum"`
+	Maximum           int32             `json:"maximum"`
+	MaxSurge          int32             `json:"maxSurge,omitempty"`
+	MaxUnavailable    int32             `json:"maxUnavailable,omitempty"`
+	Volume            SKEVolumeModel    `json:"volume"`
+	AvailabilityZones []string          `json:"availabilityZones"`
+	Labels            map[string]string `json:"labels,omitempty"`
+	Taints            []SKETaintModel   `json:"taints,omitempty"`
+}
+
+// SKEMachineModel describes the machines of a node pool.
+type SKEMachineModel struct {
+	Type  string        `json:"type"`
+	Image SKEImageModel `json:"image"`
+}
+
+// SKEImageModel is the operating system image of the machines of a node
+// pool, for example "flatcar".
+type SKEImageModel struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// SKEVolumeModel is the root volume of the machines of a node pool.
+type SKEVolumeModel struct {
+	Type string `json:"type,omitempty"`
+	Size int64  `json:"size"`
+}
+
+// SKETaintModel is a taint of the nodes of a node pool.
+type SKETaintModel struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Effect string `json:"effect"`
+}
+
+// SKEMaintenanceModel configures the maintenance of a cluster.
+type SKEMaintenanceModel struct {
+	AutoUpdate SKEAutoUpdateModel `json:"autoUpdate"`
+	TimeWindow SKETimeWindowModel `json:"timeWindow"`
+}
+
+// SKEAutoUpdateModel configures what is updated during maintenance.
+type SKEAutoUpdateModel struct {
+	KubernetesVersion   bool `json:"kubernetesVersion"`
+	MachineImageVersion bool `json:"machineImageVersion"`
+}
+
+// SKETimeWindowModel is the daily maintenance window. Start and end are
+// timestamps of which only the time of day is used, for example
+// "0000-01-01T03:00:00+02:00".
+type SKETimeWindowModel struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// SKENetworkModel attaches the nodes of a cluster to a network.
+type SKENetworkModel struct {
+	ID string `json:"id,omitempty"`
+}
+
+// SKEClusterStatusModel is the status of a cluster.
+type SKEClusterStatusModel struct {
+	Aggregated string                 `json:"aggregated,omitempty"`
+	Errors     []SKEClusterErrorModel `json:"errors,omitempty"`
+}
+
+// SKEClusterErrorModel describes why a cluster is not healthy.
+type SKEClusterErrorModel struct {
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// SKECreateKubeconfigModel is the body of a kubeconfig request. The
+// expiration is a number of seconds.
+type SKECreateKubeconfigModel struct {
+	ExpirationSeconds string `json:"expirationSeconds,omitempty"`
+}
+
+// SKEKubeconfigModel is a kubeconfig of a cluster.
+type SKEKubeconfigModel struct {
+	Kubeconfig          string     `json:"kubeconfig"`
+	ExpirationTimestamp *time.Time `json:"expirationTimestamp,omitempty"`
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stackit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+)
+
+// maintenanceDate is the date SKE expects in front of the times of the
+// maintenance window.
+const maintenanceDate = "0000-01-01T"
+
+type managedClusters struct{ c *Client }
+
+func (m *managedClusters) CreateOrUpdate(ctx context.Context, cluster cloud.ManagedCluster) (*cloud.ManagedCluster, error) {
+	var out SKEClusterModel
+	in := managedClusterToModel(&cluster)
+	if err := m.c.do(ctx, http.MethodPut, m.c.skePath("/clusters/%s", cluster.Name), in, &out); err != nil {
+		return nil, err
+	}
+	if out.Name == "" {
+		out.Name = cluster.Name
+	}
+	return managedClusterFromModel(&out), nil
+}
+
+func (m *managedClusters) Get(ctx context.Context, name string) (*cloud.ManagedCluster, error) {
+	var out SKEClusterModel
+	if err := m.c.do(ctx, http.MethodGet, m.c.skePath("/clusters/%s", name), nil, &out); err != nil {
+		return nil, err
+	}
+	return managedClusterFromModel(&out), nil
+}
+
+func (m *managedClusters) Delete(ctx context.Context, name string) error {
+	return m.c.do(ctx, http.MethodDelete, m.c.skePath("/clusters/%s", name), nil, nil)
+}
+
+func (m *managedClusters) CreateKubeconfig(ctx context.Context, name string, expiration time.Duration) (*cloud.Kubeconfig, error) {
+	var out SKEKubeconfigModel
+	in := SKECreateKubeconfigModel{ExpirationSeconds: strconv.FormatInt(int64(expiration.Seconds()), 10)}
+	if err := m.c.do(ctx, http.MethodPost, m.c.skePath("/clusters/%s/kubeconfig", name), in, &out); err != nil {
+		return nil, err
+	}
+	kubeconfig := &cloud.Kubeconfig{Kubeconfig: []byte(out.Kubeconfig)}
+	if out.ExpirationTimestamp != nil {
+		kubeconfig.ExpiresAt = *out.ExpirationTimestamp
+	}
+	return kubeconfig, nil
+}
+
+func managedClusterToModel(c *cloud.ManagedCluster) *SKEClusterModel {
+	m := &SKEClusterModel{
+		Kubernetes: SKEKubernetesModel{Version: c.KubernetesVersion},
+		Nodepools:  make([]SKENodepoolModel, 0, len(c.NodePools)),
+	}
+	for _, p := range c.NodePools {
+		pool := SKENodepoolModel{
+			Name: p.Name,
+			Machine: SKEMachineModel{
+				Type:  p.MachineType,
+				Image: SKEImageModel{Name: p.ImageName, Version: p.ImageVersion},
+			},
+			Minimum:           p.Minimum,
+			Maximum:           p.Maximum,
+			MaxSurge:          p.MaxSurge,
+			MaxUnavailable:    p.MaxUnavailable,
+			Volume:            SKEVolumeModel{Type: p.VolumeType, Size: p.VolumeSize},
+			AvailabilityZones: p.AvailabilityZones,
+			Labels:            p.Labels,
+		}
+		for _, t := range p.Taints {
+			pool.Taints = append(pool.Taints, SKETaintModel{Key: t.Key, Value: t.Value, Effect: t.Effect})
+		}
+		m.Nodepools = append(m.Nodepools, pool)
+	}
+	if c.Maintenance != nil {
+		m.Maintenance = &SKEMaintenanceModel{
+			AutoUpdate: SKEAutoUpdateModel{
+				KubernetesVersion:   c.Maintenance.AutoUpdateKubernetesVersion,
+				MachineImageVersion: c.Maintenance.AutoUpdateMachineImageVersion,
+			},
+			TimeWindow: SKETimeWindowModel{
+				Start: maintenanceDate + c.Maintenance.Start,
+				End:   maintenanceDate + c.Maintenance.End,
+			},
+		}
+	}
+	if c.NetworkID != "" {
+		m.Network = &SKENetworkModel{ID: c.NetworkID}
+	}
+	return m
+}
+
+func managedClusterFromModel(m *SKEClusterModel) *cloud.ManagedCluster {
+	c := &cloud.ManagedCluster{
+		Name:              m.Name,
+		KubernetesVersion: m.Kubernetes.Version,
+		NodePools:         make([]cloud.NodePool, 0, len(m.Nodepools)),
+	}
+	for _, p := range m.Nodepools {
+		pool := cloud.NodePool{
+			Name:              p.Name,
+			MachineType:       p.Machine.Type,
+			ImageName:         p.Machine.Image.Name,
+			ImageVersion:      p.Machine.Image.Version,
+			Minimum:           p.Minimum,
+			Maximum:           p.Maximum,
+			MaxSurge:          p.MaxSurge,
+			MaxUnavailable:    p.MaxUnavailable,
+			VolumeType:        p.Volume.Type,
+			VolumeSize:        p.Volume.Size,
+			AvailabilityZones: p.AvailabilityZones,
+			Labels:            p.Labels,
+		}
+		for _, t := range p.Taints {
+			pool.Taints = append(pool.Taints, cloud.Taint{Key: t.Key, Value: t.Value, Effect: t.Effect})
+		}
+		c.NodePools = append(c.NodePools, pool)
+	}
+	if m.Maintenance != nil {
+		c.Maintenance = &cloud.Maintenance{
+			AutoUpdateKubernetesVersion:   m.Maintenance.AutoUpdate.KubernetesVersion,
+			AutoUpdateMachineImageVersion: m.Maintenance.AutoUpdate.MachineImageVersion,
+			Start:                         strings.TrimPrefix(m.Maintenance.TimeWindow.Start, maintenanceDate),
+			End:                           strings.TrimPrefix(m.Maintenance.TimeWindow.End, maintenanceDate),
+		}
+	}
+	if m.Network != nil {
+		c.NetworkID = m.Network.ID
+	}
+	if m.Status != nil {
+		c.State = m.Status.Aggregated
+		for _, e := range m.Status.Errors {
+			c.Errors = append(c.Errors, strings.TrimSpace(e.Code+" "+e.Message))
+		}
+	}
+	return c
+}
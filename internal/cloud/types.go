@@ -0,0 +1,367 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloud
+
+import "time"
+
+// Server states as reported by the STACKIT IaaS API.
+const (
+	ServerStatusCreating = "CREATING"
+	ServerStatusActive   = "ACTIVE"
+	ServerStatusInactive = "INACTIVE"
+	ServerStatusDeleting = "DELETING"
+	ServerStatusError    = "ERROR"
+)
+
+// MaxUserDataSize is the maximum size in bytes of the base64 encoded user
+// data of a server accepted by the STACKIT IaaS API.
+const MaxUserDataSize = 65535
+
+// Server is a STACKIT compute server.
+type Server struct {
+	ID               string
+	Name             string
+	MachineType      string
+	ImageID          string
+	AvailabilityZone string
+	Status           string
+	ErrorMessage     string
+	Labels           map[string]string
+	NICs             []NIC
+	VolumeIDs        []string
+	// ServerGroupID is the ID of the server group the server is a member
+	// of, or empty.
+	ServerGroupID string
+}
+
+// NIC is a network interface of a server.
+type NIC struct {
+	ID        string
+	NetworkID string
+	IPv4      string
+	PublicIP  string
+}
+
+// BootVolume configures the boot volume of a new server.
+type BootVolume struct {
+	Size                int64
+	PerformanceClass    string
+	DeleteOnTermination bool
+}
+
+// CreateServerRequest describes a server to create.
+type CreateServerRequest struct {
+	Name             string
+	MachineType      string
+	ImageID          string
+	AvailabilityZone string
+	KeypairName      string
+	BootVolume       *BootVolume
+	NetworkID        string
+	SecurityGroupIDs []string
+	// VolumeIDs are existing volumes attached to the server in the given
+	// order when it is created.
+	VolumeIDs []string
+	// ServerGroupID is the ID of the server group the server becomes a
+	// member of. Membership cannot be changed after creation.
+	ServerGroupID string
+	UserData      []byte
+	Labels        map[string]string
+}
+
+// Network states as reported by the STACKIT IaaS API.
+const (
+	NetworkStatusCreating = "CREATING"
+	NetworkStatusCreated  = "CREATED"
+	NetworkStatusDeleting = "DELETING"
+)
+
+// Network is a STACKIT network.
+type Network struct {
+	ID          string
+	Name        string
+	Prefixes    []string
+	NameServers []string
+	Routed      bool
+	PublicIP    string
+	Status      string
+	Labels      map[string]string
+}
+
+// CreateNetworkRequest describes a network to create.
+type CreateNetworkRequest struct {
+	Name        string
+	IPv4Prefix  string
+	NameServers []string
+	Routed      bool
+	Labels      map[string]string
+}
+
+// Load balancer states as reported by the STACKIT Load Balancer API.
+const (
+	LoadBalancerStatusPending     = "STATUS_PENDING"
+	LoadBalancerStatusReady       = "STATUS_READY"
+	LoadBalancerStatusError       = "STATUS_ERROR"
+	LoadBalancerStatusTerminating = "STATUS_TERMINATING"
+)
+
+// LoadBalancer is a STACKIT network load balancer.
+type LoadBalancer struct {
+	Name            string
+	ExternalAddress string
+	PrivateAddress  string
+	Listeners       []Listener
+	TargetPools     []TargetPool
+	NetworkID       string
+	Options         LoadBalancerOptions
+	Status          string
+	Errors          []string
+	Labels          map[string]string
+}
+
+// Listener forwards traffic on a port to a target pool.
+type Listener struct {
+	Name       string
+	Port       int32
+	Protocol   string
+	TargetPool string
+}
+
+// TargetPool is a set of targets traffic is forwarded to.
+type TargetPool struct {
+	Name        string
+	TargetPort  int32
+	Targets     []Target
+	HealthCheck *HealthCheck
+}
+
+// Target is a member of a target pool.
+type Target struct {
+	DisplayName string
+	IP          string
+}
+
+// HealthCheck configures the active health check of a target pool.
+type HealthCheck struct {
+	IntervalSeconds    int32
+	TimeoutSeconds     int32
+	HealthyThreshold   int32
+	UnhealthyThreshold int32
+}
+
+// LoadBalancerOptions configures optional load balancer features.
+type LoadBalancerOptions struct {
+	PrivateNetworkOnly  bool
+	AllowedSourceRanges []string
+}
+
+// SecurityGroup is a STACKIT security group.
+type SecurityGroup struct {
+	ID          string
+	Name        string
+	Description string
+	Stateful    bool
+	Labels      map[string]string
+	Rules       []SecurityGroupRule
+}
+
+// CreateSecurityGroupRequest describes a security group to create.
+type CreateSecurityGroupRequest struct {
+	Name        string
+	Description string
+	Stateful    bool
+	Labels      map[string]string
+}
+
+// Security group rule directions.
+const (
+	DirectionIngress = "ingress"
+	DirectionEgress  = "egress"
+)
+
+// SecurityGroupRule is a rule of a security group. An empty protocol matches
+// all protocols, zero ports match all ports.
+type SecurityGroupRule struct {
+	ID                    string
+	Description           string
+	Direction             string
+	Protocol              string
+	PortRangeMin          int32
+	PortRangeMax          int32
+	RemoteIPPrefix        string
+	RemoteSecurityGroupID string
+}
+
+// Volume states as reported by the STACKIT IaaS API.
+const (
+	VolumeStatusCreating  = "CREATING"
+	VolumeStatusAvailable = "AVAILABLE"
+	VolumeStatusAttached  = "ATTACHED"
+	VolumeStatusDeleting  = "DELETING"
+	VolumeStatusError     = "ERROR"
+)
+
+// Volume is a STACKIT block volume.
+type Volume struct {
+	ID               string
+	Name             string
+	Size             int64
+	PerformanceClass string
+	AvailabilityZone string
+	Status           string
+	ServerID         string
+	Encrypted        bool
+	Labels           map[string]string
+}
+
+// CreateVolumeRequest describes a volume to create.
+type CreateVolumeRequest struct {
+	Name             string
+	Size             int64
+	PerformanceClass string
+	AvailabilityZone string
+	Encryption       *VolumeEncryption
+	Labels           map[string]string
+}
+
+// VolumeEncryption encrypts a volume with a key encryption key of the
+// STACKIT Key Management Service.
+type VolumeEncryption struct {
+	KeyRingID  string
+	KeyID      string
+	KeyVersion int64
+	// ServiceAccount is the email of the service account used to access
+	// the key.
+	ServiceAccount string
+}
+
+// PublicIP is a STACKIT public IP address.
+type PublicIP struct {
+	ID string
+	IP string
+	// NetworkInterface is the ID of the NIC the IP is associated with, or
+	// empty if it is not associated.
+	NetworkInterface string
+	Labels           map[string]string
+}
+
+// CreatePublicIPRequest describes a public IP to allocate.
+type CreatePublicIPRequest struct {
+	Labels map[string]string
+}
+
+// Server group policies as defined by the STACKIT IaaS API.
+const (
+	ServerGroupPolicyAntiAffinity     = "anti-affinity"
+	ServerGroupPolicySoftAntiAffinity = "soft-anti-affinity"
+)
+
+// ServerGroup is a STACKIT server group.
+type ServerGroup struct {
+	ID     string
+	Name   string
+	Policy string
+	// MemberIDs are the IDs of the servers in the group.
+	MemberIDs []string
+}
+
+// CreateServerGroupRequest describes a server group to create.
+type CreateServerGroupRequest struct {
+	Name   string
+	Policy string
+}
+
+// ImageStatusAvailable is the status of images servers can be created from.
+const ImageStatusAvailable = "AVAILABLE"
+
+// Image is a STACKIT image.
+type Image struct {
+	ID     string
+	Name   string
+	Status string
+	Labels map[string]string
+	// OSDistro and OSVersion describe the operating system of the image,
+	// for example "ubuntu" and "22.04".
+	OSDistro  string
+	OSVersion string
+	CreatedAt time.Time
+}
+
+// States of SKE clusters as defined by the STACKIT Kubernetes Engine API.
+const (
+	ManagedClusterStateCreating    = "STATE_CREATING"
+	ManagedClusterStateHealthy     = "STATE_HEALTHY"
+	ManagedClusterStateReconciling = "STATE_RECONCILING"
+	ManagedClusterStateUnhealthy   = "STATE_UNHEALTHY"
+	ManagedClusterStateHibernated  = "STATE_HIBERNATED"
+	ManagedClusterStateDeleting    = "STATE_DELETING"
+)
+
+// ManagedCluster is a STACKIT Kubernetes Engine (SKE) cluster.
+type ManagedCluster struct {
+	Name              string
+	KubernetesVersion string
+	NodePools         []NodePool
+	Maintenance       *Maintenance
+	// NetworkID is the ID of the network the nodes are attached to, or
+	// empty for a network managed by SKE.
+	NetworkID string
+	// State and Errors are reported by SKE and ignored when the cluster is
+	// created or updated.
+	State  string
+	Errors []string
+}
+
+// NodePool is a node pool of an SKE cluster. SKE scales the pool between
+// Minimum and Maximum nodes.
+type NodePool struct {
+	Name              string
+	MachineType       string
+	ImageName         string
+	ImageVersion      string
+	Minimum           int32
+	Maximum           int32
+	MaxSurge          int32
+	MaxUnavailable    int32
+	VolumeType        string
+	VolumeSize        int64
+	AvailabilityZones []string
+	Labels            map[string]string
+	Taints            []Taint
+}
+
+// Taint is a taint of the nodes of a node pool.
+type Taint struct {
+	Key    string
+	Value  string
+	Effect string
+}
+
+// Maintenance configures when and what SKE updates automatically. Start and
+// End are times of day in RFC 3339 format, for example "03:00:00+02:00".
+type Maintenance struct {
+	AutoUpdateKubernetesVersion   bool
+	AutoUpdateMachineImageVersion bool
+	Start                         string
+	End                           string
+}
+
+// Kubeconfig is a kubeconfig granting access to an SKE cluster.
+type Kubeconfig struct {
+	Kubeconfig []byte
+	ExpiresAt  time.Time
+}
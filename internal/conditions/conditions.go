@@ -0,0 +1,177 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conditions maintains the Cluster API style conditions of the
+// STACKIT infrastructure objects.
+package conditions
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+)
+
+// Getter is an object exposing conditions.
+type Getter interface {
+	GetConditions() infrastructurev1alpha1.Conditions
+}
+
+// Setter is an object whose conditions can be set.
+type Setter interface {
+	Getter
+	SetConditions(infrastructurev1alpha1.Conditions)
+}
+
+// Get returns the condition of the given type, or nil if it is not set.
+func Get(from Getter, t infrastructurev1alpha1.ConditionType) *infrastructurev1alpha1.Condition {
+	for _, c := range from.GetConditions() {
+		if c.Type == t {
+			return &c
+		}
+	}
+	return nil
+}
+
+// IsTrue returns true if the condition of the given type is True.
+func IsTrue(from Getter, t infrastructurev1alpha1.ConditionType) bool {
+	c := Get(from, t)
+	return c != nil && c.Status == corev1.ConditionTrue
+}
+
+// Set sets condition on to. The last transition time is only updated when
+// the status of the condition changes. Conditions are kept sorted with the
+// Ready condition first.
+func Set(to Setter, condition infrastructurev1alpha1.Condition) {
+	conditions := to.GetConditions()
+	i := slices.IndexFunc(conditions, func(c infrastructurev1alpha1.Condition) bool {
+		return c.Type == condition.Type
+	})
+	switch {
+	case i < 0:
+		condition.LastTransitionTime = metav1.Now()
+		conditions = append(conditions, condition)
+	default:
+		if conditions[i].Status == condition.Status {
+			condition.LastTransitionTime = conditions[i].LastTransitionTime
+		} else {
+			condition.LastTransitionTime = metav1.Now()
+		}
+		conditions = slices.Clone(conditions)
+		conditions[i] = condition
+	}
+	slices.SortFunc(conditions, func(a, b infrastructurev1alpha1.Condition) int {
+		switch {
+		case a.Type == b.Type:
+			return 0
+		case a.Type == infrastructurev1alpha1.ReadyCondition:
+			return -1
+		case b.Type == infrastructurev1alpha1.ReadyCondition:
+			return 1
+		}
+		return strings.Compare(string(a.Type), string(b.Type))
+	})
+	to.SetConditions(conditions)
+}
+
+// MarkTrue sets the condition of the given type to True.
+func MarkTrue(to Setter, t infrastructurev1alpha1.ConditionType) {
+	Set(to, infrastructurev1alpha1.Condition{Type: t, Status: corev1.ConditionTrue})
+}
+
+// MarkFalse sets the condition of the given type to False.
+func MarkFalse(
+	to Setter,
+	t infrastructurev1alpha1.ConditionType,
+	reason string,
+	severity infrastructurev1alpha1.ConditionSeverity,
+	messageFormat string,
+	messageArgs ...any,
+) {
+	Set(to, infrastructurev1alpha1.Condition{
+		Type:     t,
+		Status:   corev1.ConditionFalse,
+		Severity: severity,
+		Reason:   reason,
+		Message:  fmt.Sprintf(messageFormat, messageArgs...),
+	})
+}
+
+// Delete removes the condition of the given type from to.
+func Delete(to Setter, t infrastructurev1alpha1.ConditionType) {
+	conditions := to.GetConditions()
+	if !slices.ContainsFunc(conditions, func(c infrastructurev1alpha1.Condition) bool { return c.Type == t }) {
+		return
+	}
+	to.SetConditions(slices.DeleteFunc(slices.Clone(conditions), func(c infrastructurev1alpha1.Condition) bool {
+		return c.Type == t
+	}))
+}
+
+// SetSummary sets the Ready condition from the conditions of the given
+// types. Ready is True if all of them are True. Otherwise it is False with
+// the reason and message of the most severe False condition, or Unknown if
+// none is False but some have not been reported yet.
+func SetSummary(to Setter, types ...infrastructurev1alpha1.ConditionType) {
+	var worst *infrastructurev1alpha1.Condition
+	var pending []string
+	for _, t := range types {
+		c := Get(to, t)
+		switch {
+		case c == nil || c.Status == corev1.ConditionUnknown:
+			pending = append(pending, string(t))
+		case c.Status == corev1.ConditionFalse:
+			if worst == nil || severityRank(c.Severity) > severityRank(worst.Severity) {
+				worst = c
+			}
+		}
+	}
+
+	switch {
+	case worst != nil:
+		Set(to, infrastructurev1alpha1.Condition{
+			Type:     infrastructurev1alpha1.ReadyCondition,
+			Status:   corev1.ConditionFalse,
+			Severity: worst.Severity,
+			Reason:   worst.Reason,
+			Message:  worst.Message,
+		})
+	case len(pending) > 0:
+		Set(to, infrastructurev1alpha1.Condition{
+			Type:    infrastructurev1alpha1.ReadyCondition,
+			Status:  corev1.ConditionUnknown,
+			Message: fmt.Sprintf("Waiting for %s", strings.Join(pending, ", ")),
+		})
+	default:
+		MarkTrue(to, infrastructurev1alpha1.ReadyCondition)
+	}
+}
+
+func severityRank(s infrastructurev1alpha1.ConditionSeverity) int {
+	switch s {
+	case infrastructurev1alpha1.ConditionSeverityError:
+		return 3
+	case infrastructurev1alpha1.ConditionSeverityWarning:
+		return 2
+	case infrastructurev1alpha1.ConditionSeverityInfo:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+)
+
+func TestSet(t *testing.T) {
+	g := NewWithT(t)
+	obj := &infrastructurev1alpha1.StackitCluster{}
+
+	MarkFalse(obj, infrastructurev1alpha1.NetworkReadyCondition, infrastructurev1alpha1.NetworkProvisioningReason,
+		infrastructurev1alpha1.ConditionSeverityInfo, "network %s is %s", "n1", "CREATING")
+	MarkTrue(obj, infrastructurev1alpha1.LoadBalancerReadyCondition)
+	MarkTrue(obj, infrastructurev1alpha1.ReadyCondition)
+
+	g.Expect(obj.Status.Conditions).To(HaveLen(3))
+	g.Expect(obj.Status.Conditions[0].Type).To(Equal(infrastructurev1alpha1.ReadyCondition))
+	g.Expect(obj.Status.Conditions[1].Type).To(Equal(infrastructurev1alpha1.LoadBalancerReadyCondition))
+	network := Get(obj, infrastructurev1alpha1.NetworkReadyCondition)
+	g.Expect(network).NotTo(BeNil())
+	g.Expect(network.Status).To(Equal(corev1.ConditionFalse))
+	g.Expect(network.Message).To(Equal("network n1 is CREATING"))
+
+	before := metav1.NewTime(time.Now().Add(-time.Hour))
+	obj.Status.Conditions[2].LastTransitionTime = before
+	MarkFalse(obj, infrastructurev1alpha1.NetworkReadyCondition, infrastructurev1alpha1.NetworkProvisioningReason,
+		infrastructurev1alpha1.ConditionSeverityInfo, "still creating")
+	g.Expect(Get(obj, infrastructurev1alpha1.NetworkReadyCondition).LastTransitionTime).To(Equal(before))
+	MarkTrue(obj, infrastructurev1alpha1.NetworkReadyCondition)
+	g.Expect(Get(obj, infrastructurev1alpha1.NetworkReadyCondition).LastTransitionTime).NotTo(Equal(before))
+	g.Expect(IsTrue(obj, infrastructurev1alpha1.NetworkReadyCondition)).To(BeTrue())
+
+	Delete(obj, infrastructurev1alpha1.NetworkReadyCondition)
+	g.Expect(Get(obj, infrastructurev1alpha1.NetworkReadyCondition)).To(BeNil())
+	g.Expect(obj.Status.Conditions).To(HaveLen(2))
+	Delete(obj, infrastructurev1alpha1.NetworkReadyCondition)
+	g.Expect(obj.Status.Conditions).To(HaveLen(2))
+}
+
+func TestSetSummary(t *testing.T) {
+	g := NewWithT(t)
+	obj := &infrastructurev1alpha1.StackitMachine{}
+	types := []infrastructurev1alpha1.ConditionType{
+		infrastructurev1alpha1.BootstrapDataAvailableCondition,
+		infrastructurev1alpha1.InstanceReadyCondition,
+	}
+
+	SetSummary(obj, types...)
+	ready := Get(obj, infrastructurev1alpha1.ReadyCondition)
+	g.Expect(ready.Status).To(Equal(corev1.ConditionUnknown))
+
+	MarkFalse(obj, infrastructurev1alpha1.BootstrapDataAvailableCondition, infrastructurev1alpha1.WaitingForBootstrapDataReason,
+		infrastructurev1alpha1.ConditionSeverityInfo, "waiting")
+	MarkFalse(obj, infrastructurev1alpha1.InstanceReadyCondition, infrastructurev1alpha1.InstanceProvisionFailedReason,
+		infrastructurev1alpha1.ConditionSeverityError, "quota exceeded")
+	SetSummary(obj, types...)
+	ready = Get(obj, infrastructurev1alpha1.ReadyCondition)
+	g.Expect(ready.Status).To(Equal(corev1.ConditionFalse))
+	g.Expect(ready.Reason).To(Equal(infrastructurev1alpha1.InstanceProvisionFailedReason))
+	g.Expect(ready.Severity).To(Equal(infrastructurev1alpha1.ConditionSeverityError))
+	g.Expect(ready.Message).To(Equal("quota exceeded"))
+
+	MarkTrue(obj, infrastructurev1alpha1.BootstrapDataAvailableCondition)
+	MarkTrue(obj, infrastructurev1alpha1.InstanceReadyCondition)
+	SetSummary(obj, types...)
+	g.Expect(IsTrue(obj, infrastructurev1alpha1.ReadyCondition)).To(BeTrue())
+}
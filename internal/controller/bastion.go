@@ -0,0 +1,307 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/conditions"
+)
+
+// bastionRole is the role label of the bastion server and its security
+// group and public IP.
+const bastionRole = "bastion"
+
+// sshPort is the port the bastion host and the machines accept SSH on.
+const sshPort = 22
+
+// bastionLabels returns the labels identifying the STACKIT resources of the
+// bastion host of stackitCluster.
+func bastionLabels(stackitCluster *infrastructurev1alpha1.StackitCluster) map[string]string {
+	labels := clusterLabels(stackitCluster)
+	labels[cloud.RoleLabel] = bastionRole
+	return labels
+}
+
+// reconcileBastion creates the bastion host of the cluster with its security
+// group and public IP, and records its addresses in the status. It returns
+// false while the bastion server is not active yet.
+func (r *StackitClusterReconciler) reconcileBastion(
+	ctx context.Context,
+	stackit cloud.Interface,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+) (bool, error) {
+	log := logf.FromContext(ctx)
+	spec := stackitCluster.Spec.Bastion
+	labels := bastionLabels(stackitCluster)
+
+	group, err := r.reconcileBastionSecurityGroup(ctx, stackit, stackitCluster)
+	if err != nil {
+		return false, err
+	}
+	if stackitCluster.Status.Bastion == nil {
+		stackitCluster.Status.Bastion = &infrastructurev1alpha1.BastionStatus{}
+	}
+	status := stackitCluster.Status.Bastion
+	status.SecurityGroupID = group.ID
+
+	ips, err := stackit.PublicIPs().List(ctx, labels)
+	if err != nil {
+		return false, fmt.Errorf("listing public IPs: %w", err)
+	}
+	var ip *cloud.PublicIP
+	switch len(ips) {
+	case 0:
+		ip, err = stackit.PublicIPs().Create(ctx, cloud.CreatePublicIPRequest{Labels: labels})
+		if err != nil {
+			return false, fmt.Errorf("allocating public IP: %w", err)
+		}
+		log.Info("Allocated bastion public IP", "publicIPID", ip.ID, "address", ip.IP)
+	case 1:
+		ip = &ips[0]
+	default:
+		return false, fmt.Errorf("found %d bastion public IPs for the cluster", len(ips))
+	}
+
+	servers, err := stackit.Servers().List(ctx, labels)
+	if err != nil {
+		return false, fmt.Errorf("listing servers: %w", err)
+	}
+	var server *cloud.Server
+	switch len(servers) {
+	case 0:
+		imageID, err := newImageResolver(stackit).resolve(ctx, spec.Image, "")
+		if err != nil {
+			return false, err
+		}
+		server, err = stackit.Servers().Create(ctx, cloud.CreateServerRequest{
+			Name:             resourceName(stackitCluster, bastionRole),
+			MachineType:      spec.MachineType,
+			ImageID:          imageID,
+			AvailabilityZone: spec.AvailabilityZone,
+			KeypairName:      spec.SSHKeyName,
+			NetworkID:        stackitCluster.Status.Network.ID,
+			SecurityGroupIDs: []string{group.ID},
+			Labels:           labels,
+		})
+		if err != nil {
+			return false, fmt.Errorf("creating bastion server: %w", err)
+		}
+		log.Info("Created bastion server", "serverID", server.ID)
+	case 1:
+		server = &servers[0]
+	default:
+		return false, fmt.Errorf("found %d bastion servers for the cluster", len(servers))
+	}
+	status.ID = server.ID
+	status.State = infrastructurev1alpha1.InstanceState(server.Status)
+
+	switch server.Status {
+	case cloud.ServerStatusActive:
+	case cloud.ServerStatusError:
+		return false, fmt.Errorf("bastion server %s is in state ERROR: %s", server.ID, server.ErrorMessage)
+	default:
+		log.Info("Waiting for the bastion server to become active", "serverID", server.ID, "state", server.Status)
+		conditions.MarkFalse(stackitCluster, infrastructurev1alpha1.BastionReadyCondition,
+			infrastructurev1alpha1.BastionProvisioningReason, infrastructurev1alpha1.ConditionSeverityInfo,
+			"Server %s is in state %s", server.ID, server.Status)
+		return false, nil
+	}
+
+	if err := associatePublicIP(ctx, stackit, server, ip); err != nil {
+		return false, err
+	}
+	status.Address = ip.IP
+	status.PrivateAddress = server.NICs[0].IPv4
+	return true, nil
+}
+
+// reconcileBastionSecurityGroup looks up or creates the security group of
+// the bastion host and makes its ingress rules allow exactly SSH from the
+// allowed CIDRs. The managed security groups of the machines are extended
+// to allow SSH from the bastion host.
+func (r *StackitClusterReconciler) reconcileBastionSecurityGroup(
+	ctx context.Context,
+	stackit cloud.Interface,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+) (*cloud.SecurityGroup, error) {
+	log := logf.FromContext(ctx)
+	labels := bastionLabels(stackitCluster)
+
+	groups, err := stackit.SecurityGroups().List(ctx, labels)
+	if err != nil {
+		return nil, fmt.Errorf("listing security groups: %w", err)
+	}
+	var group *cloud.SecurityGroup
+	switch len(groups) {
+	case 0:
+		group, err = stackit.SecurityGroups().Create(ctx, cloud.CreateSecurityGroupRequest{
+			Name:        resourceName(stackitCluster, bastionRole),
+			Description: fmt.Sprintf("Kubernetes cluster %s/%s bastion host", stackitCluster.Namespace, stackitCluster.Name),
+			Stateful:    true,
+			Labels:      labels,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("creating bastion security group: %w", err)
+		}
+		log.Info("Created security group", "securityGroupID", group.ID, "role", bastionRole)
+	case 1:
+		group = &groups[0]
+	default:
+		return nil, fmt.Errorf("found %d bastion security groups for the cluster", len(groups))
+	}
+
+	want := make([]cloud.SecurityGroupRule, 0, len(stackitCluster.Spec.Bastion.AllowedCIDRs))
+	for _, cidr := range stackitCluster.Spec.Bastion.AllowedCIDRs {
+		want = append(want, cloud.SecurityGroupRule{
+			Description:    "SSH",
+			Direction:      cloud.DirectionIngress,
+			Protocol:       "tcp",
+			PortRangeMin:   sshPort,
+			PortRangeMax:   sshPort,
+			RemoteIPPrefix: cidr,
+		})
+	}
+	if err := syncSecurityGroupRules(ctx, stackit, group, want); err != nil {
+		return nil, fmt.Errorf("reconciling rules of bastion security group: %w", err)
+	}
+
+	// The rule is also part of the rules of the machine security groups, but
+	// is added here to not wait for their next reconciliation.
+	fromBastion := sshFromBastionRule(group.ID)
+	for _, role := range []infrastructurev1alpha1.SecurityGroupRole{
+		infrastructurev1alpha1.SecurityGroupControlPlane,
+		infrastructurev1alpha1.SecurityGroupWorker,
+	} {
+		status, ok := stackitCluster.Status.SecurityGroups[role]
+		if !ok || status.Unmanaged {
+			continue
+		}
+		machines, err := stackit.SecurityGroups().Get(ctx, status.ID)
+		if err != nil {
+			return nil, fmt.Errorf("getting %s security group %s: %w", role, status.ID, err)
+		}
+		if slices.ContainsFunc(machines.Rules, func(have cloud.SecurityGroupRule) bool { return sameRule(have, fromBastion) }) {
+			continue
+		}
+		if _, err := stackit.SecurityGroups().CreateRule(ctx, machines.ID, fromBastion); err != nil {
+			return nil, fmt.Errorf("adding rule %q to %s security group: %w", fromBastion.Description, role, err)
+		}
+	}
+	return group, nil
+}
+
+// deleteBastion deletes the bastion server, releases its public IP and
+// deletes its security group. It returns false while the server is being
+// deleted.
+func (r *StackitClusterReconciler) deleteBastion(
+	ctx context.Context,
+	stackit cloud.Interface,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+) (bool, error) {
+	log := logf.FromContext(ctx)
+	labels := bastionLabels(stackitCluster)
+
+	servers, err := stackit.Servers().List(ctx, labels)
+	if err != nil {
+		return false, fmt.Errorf("listing servers: %w", err)
+	}
+	for _, server := range servers {
+		if server.Status == cloud.ServerStatusDeleting {
+			continue
+		}
+		log.Info("Deleting bastion server", "serverID", server.ID)
+		if err := stackit.Servers().Delete(ctx, server.ID); err != nil && !cloud.IsNotFound(err) {
+			return false, fmt.Errorf("deleting bastion server %s: %w", server.ID, err)
+		}
+	}
+	if len(servers) > 0 {
+		return false, nil
+	}
+
+	ips, err := stackit.PublicIPs().List(ctx, labels)
+	if err != nil {
+		return false, fmt.Errorf("listing public IPs: %w", err)
+	}
+	for _, ip := range ips {
+		log.Info("Releasing bastion public IP", "publicIPID", ip.ID, "address", ip.IP)
+		if err := stackit.PublicIPs().Delete(ctx, ip.ID); err != nil && !cloud.IsNotFound(err) {
+			return false, fmt.Errorf("releasing public IP %s: %w", ip.ID, err)
+		}
+	}
+
+	groups, err := stackit.SecurityGroups().List(ctx, labels)
+	if err != nil {
+		return false, fmt.Errorf("listing security groups: %w", err)
+	}
+	for _, group := range groups {
+		if err := removeRulesReferencing(ctx, stackit, stackitCluster, group.ID); err != nil {
+			return false, err
+		}
+		log.Info("Deleting security group", "securityGroupID", group.ID, "role", bastionRole)
+		err := stackit.SecurityGroups().Delete(ctx, group.ID)
+		if cloud.IsConflict(err) {
+			log.Info("Waiting for the security group to be unused", "securityGroupID", group.ID)
+			return false, nil
+		}
+		if err != nil && !cloud.IsNotFound(err) {
+			return false, fmt.Errorf("deleting security group %s: %w", group.ID, err)
+		}
+	}
+
+	stackitCluster.Status.Bastion = nil
+	conditions.Delete(stackitCluster, infrastructurev1alpha1.BastionReadyCondition)
+	return true, nil
+}
+
+// removeRulesReferencing removes the rules allowing traffic from the
+// security group with the given ID from the managed security groups of the
+// machines.
+func removeRulesReferencing(
+	ctx context.Context,
+	stackit cloud.Interface,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+	groupID string,
+) error {
+	for role, status := range stackitCluster.Status.SecurityGroups {
+		if status.Unmanaged {
+			continue
+		}
+		machines, err := stackit.SecurityGroups().Get(ctx, status.ID)
+		if cloud.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("getting %s security group %s: %w", role, status.ID, err)
+		}
+		for _, rule := range machines.Rules {
+			if rule.RemoteSecurityGroupID != groupID {
+				continue
+			}
+			if err := stackit.SecurityGroups().DeleteRule(ctx, machines.ID, rule.ID); err != nil && !cloud.IsNotFound(err) {
+				return fmt.Errorf("removing rule %s from %s security group: %w", rule.ID, role, err)
+			}
+		}
+	}
+	return nil
+}
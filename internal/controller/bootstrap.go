@@ -0,0 +1,87 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/capi/v1beta1"
+)
+
+// bootstrapFormat is the format of the bootstrap data of a machine, as
+// stored by Cluster API bootstrap providers in the "format" key of the
+// bootstrap data secret.
+type bootstrapFormat string
+
+const (
+	// cloudConfigFormat is bootstrap data processed by cloud-init, for
+	// example a cloud-config document or a shell script.
+	cloudConfigFormat bootstrapFormat = "cloud-config"
+
+	// ignitionFormat is an Ignition config in JSON.
+	ignitionFormat bootstrapFormat = "ignition"
+)
+
+// bootstrapData is the bootstrap data of a machine.
+type bootstrapData struct {
+	value  []byte
+	format bootstrapFormat
+}
+
+// getBootstrapData returns the bootstrap data stored in the data secret of
+// bootstrap, a field of a Machine or of the template of a MachinePool in
+// namespace. The format is taken from the secret, or detected from the data
+// for bootstrap providers not setting it.
+func getBootstrapData(ctx context.Context, c client.Client, namespace string, bootstrap clusterv1.Bootstrap) (*bootstrapData, error) {
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Namespace: namespace, Name: *bootstrap.DataSecretName}
+	if err := c.Get(ctx, key, secret); err != nil {
+		return nil, fmt.Errorf("getting bootstrap data secret %s: %w", key, err)
+	}
+	value, ok := secret.Data["value"]
+	if !ok {
+		return nil, fmt.Errorf("bootstrap data secret %s has no value key", key)
+	}
+
+	format := bootstrapFormat(secret.Data["format"])
+	switch format {
+	case "":
+		format = detectBootstrapFormat(value)
+	case cloudConfigFormat, ignitionFormat:
+	default:
+		return nil, fmt.Errorf("bootstrap data secret %s has unsupported format %q", key, format)
+	}
+	return &bootstrapData{value: value, format: format}, nil
+}
+
+// detectBootstrapFormat returns the format of value. Ignition configs are
+// recognized by their top-level "ignition" object, everything else is left
+// to cloud-init.
+func detectBootstrapFormat(value []byte) bootstrapFormat {
+	var config struct {
+		Ignition json.RawMessage `json:"ignition"`
+	}
+	if err := json.Unmarshal(value, &config); err == nil && len(config.Ignition) > 0 {
+		return ignitionFormat
+	}
+	return cloudConfigFormat
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	clusterv1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/capi/v1beta1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/conditions"
+)
+
+// requeueAfter is the interval in which STACKIT resources that are still
+// being provisioned or deleted are polled.
+const requeueAfter = 15 * time.Second
+
+// getOwnerMachine returns the Machine owning obj, or nil if the Machine
+// controller has not set the owner reference yet.
+func getOwnerMachine(ctx context.Context, c client.Client, obj metav1.ObjectMeta) (*clusterv1.Machine, error) {
+	for _, ref := range obj.OwnerReferences {
+		if ref.Kind != "Machine" {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+		if gv.Group != clusterv1.GroupVersion.Group {
+			continue
+		}
+		machine := &clusterv1.Machine{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: obj.Namespace, Name: ref.Name}, machine); err != nil {
+			return nil, err
+		}
+		return machine, nil
+	}
+	return nil, nil
+}
+
+// getOwnerMachinePool returns the MachinePool owning obj, or nil if the
+// MachinePool controller has not set the owner reference yet.
+func getOwnerMachinePool(ctx context.Context, c client.Client, obj metav1.ObjectMeta) (*clusterv1.MachinePool, error) {
+	for _, ref := range obj.OwnerReferences {
+		if ref.Kind != "MachinePool" {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+		if gv.Group != clusterv1.GroupVersion.Group {
+			continue
+		}
+		machinePool := &clusterv1.MachinePool{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: obj.Namespace, Name: ref.Name}, machinePool); err != nil {
+			return nil, err
+		}
+		return machinePool, nil
+	}
+	return nil, nil
+}
+
+// getOwnerCluster returns the Cluster owning obj, or nil if the Cluster
+// controller has not set the owner reference yet.
+func getOwnerCluster(ctx context.Context, c client.Client, obj metav1.ObjectMeta) (*clusterv1.Cluster, error) {
+	for _, ref := range obj.OwnerReferences {
+		if ref.Kind != "Cluster" {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+		if gv.Group != clusterv1.GroupVersion.Group {
+			continue
+		}
+		return getCluster(ctx, c, obj.Namespace, ref.Name)
+	}
+	return nil, nil
+}
+
+// getCluster returns the Cluster with the given name.
+func getCluster(ctx context.Context, c client.Client, namespace, name string) (*clusterv1.Cluster, error) {
+	cluster := &clusterv1.Cluster{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, cluster); err != nil {
+		return nil, err
+	}
+	return cluster, nil
+}
+
+// getStackitCluster returns the StackitCluster referenced by the
+// infrastructureRef of cluster, or nil if the reference is not set yet.
+func getStackitCluster(ctx context.Context, c client.Client, cluster *clusterv1.Cluster) (*infrastructurev1alpha1.StackitCluster, error) {
+	ref := cluster.Spec.InfrastructureRef
+	if ref == nil {
+		return nil, nil
+	}
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = cluster.Namespace
+	}
+	stackitCluster := &infrastructurev1alpha1.StackitCluster{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, stackitCluster); err != nil {
+		return nil, err
+	}
+	return stackitCluster, nil
+}
+
+// isPaused returns true if reconciliation of obj is paused, either through
+// the paused annotation on obj or because cluster is paused.
+func isPaused(cluster *clusterv1.Cluster, obj metav1.Object) bool {
+	if _, ok := obj.GetAnnotations()[clusterv1.PausedAnnotation]; ok {
+		return true
+	}
+	return cluster != nil && cluster.Spec.Paused
+}
+
+// isControlPlaneMachine returns true if machine is part of the control plane.
+func isControlPlaneMachine(machine *clusterv1.Machine) bool {
+	_, ok := machine.Labels[clusterv1.MachineControlPlaneLabel]
+	return ok
+}
+
+// isInfrastructureRef returns true if ref references an object of the given
+// kind in the infrastructure API group.
+func isInfrastructureRef(ref *corev1.ObjectReference, kind string) bool {
+	if ref == nil || ref.Kind != kind {
+		return false
+	}
+	return ref.GroupVersionKind().Group == infrastructurev1alpha1.GroupVersion.Group
+}
+
+// cloudScope returns the STACKIT project, region and credentials of
+// stackitCluster.
+func cloudScope(ctx context.Context, c client.Client, stackitCluster *infrastructurev1alpha1.StackitCluster) (cloud.Scope, error) {
+	return scopeFor(ctx, c, stackitCluster.Namespace, stackitCluster.Spec.IdentityRef,
+		stackitCluster.Spec.ProjectID, stackitCluster.Spec.Region)
+}
+
+// scopeFor returns the scope of a STACKIT project and region, using the
+// credentials referenced by identityRef from namespace. The credentials are
+// read on every call, so rotated credentials are used without a restart.
+func scopeFor(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	identityRef *infrastructurev1alpha1.StackitIdentityReference,
+	projectID, region string,
+) (cloud.Scope, error) {
+	creds, err := getCredentials(ctx, c, namespace, identityRef)
+	if err != nil {
+		return cloud.Scope{}, err
+	}
+	if region == "" {
+		region = infrastructurev1alpha1.DefaultRegion
+	}
+	return cloud.Scope{ProjectID: projectID, Region: region, Credentials: creds}, nil
+}
+
+// newCloudClient returns a STACKIT client for the project, region and
+// credentials of stackitCluster.
+func newCloudClient(
+	ctx context.Context,
+	c client.Client,
+	factory cloud.Factory,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+) (cloud.Interface, error) {
+	scope, err := cloudScope(ctx, c, stackitCluster)
+	if err != nil {
+		return nil, err
+	}
+	stackit, err := factory(ctx, scope)
+	if err != nil {
+		return nil, fmt.Errorf("creating STACKIT client: %w", err)
+	}
+	return stackit, nil
+}
+
+// markDeletionBlocked reports on the condition t of obj, which is being
+// deleted, that its STACKIT resources cannot be deleted because of err, and
+// how to delete obj anyway. It returns err, so that the deletion is retried.
+func markDeletionBlocked(obj conditions.Setter, t infrastructurev1alpha1.ConditionType, finalizer string, err error) error {
+	conditions.MarkFalse(obj, t, infrastructurev1alpha1.DeletionBlockedReason, infrastructurev1alpha1.ConditionSeverityError,
+		"%s; remove the finalizer %s to delete the object without deleting its STACKIT resources", err, finalizer)
+	return err
+}
+
+// managedCloudScope returns the STACKIT project, region and credentials of
+// the SKE cluster of controlPlane.
+func managedCloudScope(ctx context.Context, c client.Client, controlPlane *infrastructurev1alpha1.StackitManagedControlPlane) (cloud.Scope, error) {
+	return scopeFor(ctx, c, controlPlane.Namespace, controlPlane.Spec.IdentityRef,
+		controlPlane.Spec.ProjectID, controlPlane.Spec.Region)
+}
+
+// getManagedControlPlane returns the StackitManagedControlPlane referenced by
+// the controlPlaneRef of cluster, or nil if the reference is not set, does
+// not reference a StackitManagedControlPlane or the object does not exist.
+func getManagedControlPlane(ctx context.Context, c client.Client, cluster *clusterv1.Cluster) (*infrastructurev1alpha1.StackitManagedControlPlane, error) {
+	ref := cluster.Spec.ControlPlaneRef
+	if !isInfrastructureRef(ref, "StackitManagedControlPlane") {
+		return nil, nil
+	}
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = cluster.Namespace
+	}
+	controlPlane := &infrastructurev1alpha1.StackitManagedControlPlane{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, controlPlane); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	return controlPlane, nil
+}
+
+// resourceName returns the name of a STACKIT resource created for obj.
+// Names exceeding the 63 characters allowed for load balancers are shortened
+// and kept unique by a hash of the full name.
+func resourceName(obj metav1.Object, suffix string) string {
+	name := fmt.Sprintf("%s-%s-%s", obj.GetNamespace(), obj.GetName(), suffix)
+	if len(name) <= 63 {
+		return name
+	}
+	sum := sha256.Sum256([]byte(name))
+	return strings.TrimRight(name[:54], "-") + "-" + hex.EncodeToString(sum[:])[:8]
+}
+
+// patchObject patches obj, including its status, with the changes made since
+// before. Objects which are already gone are ignored.
+func patchObject(ctx context.Context, c client.Client, before, obj client.Object) error {
+	var errs []error
+	// The status patch operates on a copy: its response carries the spec and
+	// metadata as stored, which would drop the changes not yet persisted.
+	status := obj.DeepCopyObject().(client.Object)
+	if err := c.Status().Patch(ctx, status, client.MergeFrom(before)); err != nil && !apierrors.IsNotFound(err) {
+		errs = append(errs, err)
+	}
+	if err := c.Patch(ctx, obj, client.MergeFrom(before)); err != nil && !apierrors.IsNotFound(err) {
+		errs = append(errs, err)
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// associatePublicIP associates ip with the first network interface of
+// server unless it already is.
+func associatePublicIP(ctx context.Context, stackit cloud.Interface, server *cloud.Server, ip *cloud.PublicIP) error {
+	if len(server.NICs) == 0 {
+		return fmt.Errorf("server %s has no network interface", server.ID)
+	}
+	switch ip.NetworkInterface {
+	case server.NICs[0].ID:
+	case "":
+		if err := stackit.PublicIPs().Associate(ctx, server.ID, ip.ID); err != nil {
+			return fmt.Errorf("associating public IP %s: %w", ip.ID, err)
+		}
+		logf.FromContext(ctx).Info("Associated public IP", "publicIPID", ip.ID, "address", ip.IP)
+	default:
+		// A replaced server may not have released the IP yet.
+		return fmt.Errorf("public IP %s is associated with another server", ip.ID)
+	}
+	server.NICs[0].PublicIP = ip.IP
+	return nil
+}
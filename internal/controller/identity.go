@@ -0,0 +1,113 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+)
+
+// errIdentityNotAllowed is returned for StackitClusterIdentities which do not
+// allow the namespace of the cluster referencing them.
+var errIdentityNotAllowed = errors.New("identity is not allowed in the namespace of the cluster")
+
+// getCredentials returns the credentials referenced by the identityRef ref of
+// an object in namespace, or nil if the default credentials of the
+// controller are to be used.
+func getCredentials(ctx context.Context, c client.Client, namespace string, ref *infrastructurev1alpha1.StackitIdentityReference) (*cloud.Credentials, error) {
+	if ref == nil {
+		return nil, nil
+	}
+	switch ref.Kind {
+	case infrastructurev1alpha1.SecretIdentityKind:
+		return getSecretCredentials(ctx, c, client.ObjectKey{Namespace: namespace, Name: ref.Name})
+	case infrastructurev1alpha1.ClusterIdentityKind:
+		identity := &infrastructurev1alpha1.StackitClusterIdentity{}
+		if err := c.Get(ctx, client.ObjectKey{Name: ref.Name}, identity); err != nil {
+			return nil, fmt.Errorf("getting StackitClusterIdentity %s: %w", ref.Name, err)
+		}
+		allowed, err := isNamespaceAllowed(ctx, c, identity.Spec.AllowedNamespaces, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, fmt.Errorf("StackitClusterIdentity %s: %w %s", ref.Name, errIdentityNotAllowed, namespace)
+		}
+		// Identities created before secretRef was validated may lack it.
+		if identity.Spec.SecretRef.Namespace == "" {
+			return nil, fmt.Errorf("StackitClusterIdentity %s: secretRef.namespace must be set", ref.Name)
+		}
+		return getSecretCredentials(ctx, c, client.ObjectKey{
+			Namespace: identity.Spec.SecretRef.Namespace,
+			Name:      identity.Spec.SecretRef.Name,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported identity kind %q", ref.Kind)
+	}
+}
+
+// getSecretCredentials returns the credentials stored in the given Secret.
+func getSecretCredentials(ctx context.Context, c client.Client, key client.ObjectKey) (*cloud.Credentials, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, key, secret); err != nil {
+		return nil, fmt.Errorf("getting credentials secret %s: %w", key, err)
+	}
+	creds, err := cloud.CredentialsFromSecretData(secret.Data)
+	if err != nil {
+		return nil, fmt.Errorf("credentials secret %s: %w", key, err)
+	}
+	return creds, nil
+}
+
+// isNamespaceAllowed returns true if allowed selects namespace. A nil
+// AllowedNamespaces allows no namespace, an empty one all namespaces.
+func isNamespaceAllowed(ctx context.Context, c client.Client, allowed *infrastructurev1alpha1.AllowedNamespaces, namespace string) (bool, error) {
+	if allowed == nil {
+		return false, nil
+	}
+	if allowed.NamespaceList == nil && allowed.Selector == nil {
+		return true, nil
+	}
+	if slices.Contains(allowed.NamespaceList, namespace) {
+		return true, nil
+	}
+	if allowed.Selector == nil {
+		return false, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(allowed.Selector)
+	if err != nil {
+		return false, fmt.Errorf("invalid namespace selector: %w", err)
+	}
+	if selector.Empty() {
+		return false, nil
+	}
+	ns := &corev1.Namespace{}
+	if err := c.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(ns.Labels)), nil
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+)
+
+// ignitionObjectKey returns the key the Ignition config of stackitMachine is
+// uploaded to.
+func ignitionObjectKey(stackitMachine *infrastructurev1alpha1.StackitMachine) string {
+	return fmt.Sprintf("%s/%s-%s.ign", stackitMachine.Namespace, stackitMachine.Name, stackitMachine.UID)
+}
+
+// ignitionStub returns the config passed to the server instead of the
+// Ignition config of the bootstrap data, which replaces itself with the
+// config uploaded by uploadIgnition fetched from a presigned URL.
+func ignitionStub(
+	storage cloud.ObjectStorage,
+	stackitMachine *infrastructurev1alpha1.StackitMachine,
+	config []byte,
+) ([]byte, error) {
+	// The stub has to be understood by the same Ignition versions as the
+	// uploaded config.
+	var parsed struct {
+		Ignition struct {
+			Version string `json:"version"`
+		} `json:"ignition"`
+	}
+	if err := json.Unmarshal(config, &parsed); err != nil {
+		return nil, fmt.Errorf("parsing Ignition config: %w", err)
+	}
+	if parsed.Ignition.Version == "" {
+		return nil, fmt.Errorf("the Ignition config has no version")
+	}
+
+	expiry := infrastructurev1alpha1.DefaultPresignedURLExpiry
+	if d := stackitMachine.Spec.Ignition.ObjectStorage.PresignedURLExpiry; d != nil {
+		expiry = d.Duration
+	}
+	// Presigning does not require the object to exist yet.
+	url, err := storage.PresignGetObject(ignitionObjectKey(stackitMachine), expiry)
+	if err != nil {
+		return nil, fmt.Errorf("presigning URL of Ignition config: %w", err)
+	}
+
+	stub := map[string]any{
+		"ignition": map[string]any{
+			"version": parsed.Ignition.Version,
+			"config": map[string]any{
+				"replace": map[string]any{"source": url},
+			},
+		},
+	}
+	return json.Marshal(stub)
+}
+
+// uploadIgnition uploads the Ignition config to the bucket of the spec.
+func uploadIgnition(
+	ctx context.Context,
+	storage cloud.ObjectStorage,
+	stackitMachine *infrastructurev1alpha1.StackitMachine,
+	config []byte,
+) error {
+	key := ignitionObjectKey(stackitMachine)
+	if err := storage.PutObject(ctx, key, config); err != nil {
+		return fmt.Errorf("uploading Ignition config: %w", err)
+	}
+	stackitMachine.Status.IgnitionObjectKey = key
+	logf.FromContext(ctx).Info("Uploaded Ignition config",
+		"bucket", stackitMachine.Spec.Ignition.ObjectStorage.Bucket, "key", key)
+	return nil
+}
+
+// deleteIgnitionObject deletes the Ignition config uploaded for
+// stackitMachine.
+func (r *StackitMachineReconciler) deleteIgnitionObject(
+	ctx context.Context,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+	stackitMachine *infrastructurev1alpha1.StackitMachine,
+) error {
+	log := logf.FromContext(ctx)
+	key := stackitMachine.Status.IgnitionObjectKey
+
+	if stackitMachine.Spec.Ignition == nil {
+		log.Info("Not deleting Ignition config, the bucket is no longer known", "key", key)
+		stackitMachine.Status.IgnitionObjectKey = ""
+		return nil
+	}
+	storage, err := r.objectStorage(ctx, stackitCluster, stackitMachine)
+	if err != nil {
+		return err
+	}
+	if err := storage.DeleteObject(ctx, key); err != nil {
+		return fmt.Errorf("deleting Ignition config %s: %w", key, err)
+	}
+	log.Info("Deleted Ignition config", "bucket", stackitMachine.Spec.Ignition.ObjectStorage.Bucket, "key", key)
+	stackitMachine.Status.IgnitionObjectKey = ""
+	return nil
+}
+
+// objectStorage returns a client of the bucket Ignition configs of
+// stackitMachine are uploaded to.
+func (r *StackitMachineReconciler) objectStorage(
+	ctx context.Context,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+	stackitMachine *infrastructurev1alpha1.StackitMachine,
+) (cloud.ObjectStorage, error) {
+	spec := stackitMachine.Spec.Ignition.ObjectStorage
+
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Namespace: stackitMachine.Namespace, Name: spec.CredentialsSecret.Name}
+	if err := r.Get(ctx, key, secret); err != nil {
+		return nil, fmt.Errorf("getting object storage credentials secret %s: %w", key, err)
+	}
+	creds, err := cloud.ObjectStorageCredentialsFromSecretData(secret.Data)
+	if err != nil {
+		return nil, fmt.Errorf("object storage credentials secret %s: %w", key, err)
+	}
+
+	region := stackitCluster.Spec.Region
+	if region == "" {
+		region = infrastructurev1alpha1.DefaultRegion
+	}
+	storage, err := r.ObjectStorageFactory(ctx, cloud.ObjectStorageScope{
+		Endpoint:    spec.Endpoint,
+		Region:      region,
+		Bucket:      spec.Bucket,
+		Credentials: *creds,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating object storage client: %w", err)
+	}
+	return storage, nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+)
+
+// imageResolver resolves image specs to image IDs. It lists the images of
+// the project at most once, so it is meant to be used for a single
+// reconciliation.
+type imageResolver struct {
+	stackit cloud.Interface
+	images  []cloud.Image
+}
+
+func newImageResolver(stackit cloud.Interface) *imageResolver {
+	return &imageResolver{stackit: stackit}
+}
+
+func (r *imageResolver) list(ctx context.Context) ([]cloud.Image, error) {
+	if r.images != nil {
+		return r.images, nil
+	}
+	images, err := r.stackit.Images().List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing images: %w", err)
+	}
+	r.images = append([]cloud.Image{}, images...)
+	return r.images, nil
+}
+
+// resolve returns the ID of the image selected by spec. kubernetesVersion is
+// the version images are matched against if the selector requires it.
+func (r *imageResolver) resolve(ctx context.Context, spec infrastructurev1alpha1.ImageSpec, kubernetesVersion string) (string, error) {
+	switch {
+	case spec.ID != nil:
+		return *spec.ID, nil
+	case spec.Name != nil:
+		images, err := r.list(ctx)
+		if err != nil {
+			return "", err
+		}
+		var ids []string
+		for _, image := range images {
+			if image.Name == *spec.Name {
+				ids = append(ids, image.ID)
+			}
+		}
+		switch len(ids) {
+		case 0:
+			return "", fmt.Errorf("no image named %q found", *spec.Name)
+		case 1:
+			return ids[0], nil
+		default:
+			return "", fmt.Errorf("found %d images named %q", len(ids), *spec.Name)
+		}
+	case spec.Selector != nil:
+		images, err := r.list(ctx)
+		if err != nil {
+			return "", err
+		}
+		image, err := selectImage(images, spec.Selector, kubernetesVersion)
+		if err != nil {
+			return "", err
+		}
+		return image.ID, nil
+	default:
+		return "", fmt.Errorf("image has neither id, name nor selector set")
+	}
+}
+
+// selectImage returns the newest available image matching selector. Images
+// created at the same time are ordered by name, so that the selection is
+// stable.
+func selectImage(images []cloud.Image, selector *infrastructurev1alpha1.ImageSelector, kubernetesVersion string) (*cloud.Image, error) {
+	if selector.MatchKubernetesVersion && kubernetesVersion == "" {
+		return nil, fmt.Errorf("the image selector matches the Kubernetes version, but none is set")
+	}
+	var matches []cloud.Image
+	for _, image := range images {
+		if image.Status != cloud.ImageStatusAvailable {
+			continue
+		}
+		if selector.NamePattern != "" {
+			ok, err := path.Match(selector.NamePattern, image.Name)
+			if err != nil {
+				return nil, fmt.Errorf("invalid image name pattern %q: %w", selector.NamePattern, err)
+			}
+			if !ok {
+				continue
+			}
+		}
+		if !cloud.MatchLabels(image.Labels, selector.MatchLabels) {
+			continue
+		}
+		if selector.MatchKubernetesVersion && !sameKubernetesVersion(image.Labels[infrastructurev1alpha1.ImageKubernetesVersionLabel], kubernetesVersion) {
+			continue
+		}
+		if os := selector.OS; os != nil {
+			if !strings.EqualFold(image.OSDistro, os.Distro) || (os.Version != "" && image.OSVersion != os.Version) {
+				continue
+			}
+		}
+		matches = append(matches, image)
+	}
+	if len(matches) == 0 {
+		if selector.MatchKubernetesVersion {
+			return nil, fmt.Errorf("no available image for Kubernetes %s matches the image selector", kubernetesVersion)
+		}
+		return nil, errors.New("no available image matches the image selector")
+	}
+	newest := slices.MaxFunc(matches, func(a, b cloud.Image) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), strings.Compare(a.Name, b.Name))
+	})
+	return &newest, nil
+}
+
+// sameKubernetesVersion reports whether two Kubernetes versions are equal,
+// ignoring a "v" prefix.
+func sameKubernetesVersion(a, b string) bool {
+	return a != "" && strings.TrimPrefix(a, "v") == strings.TrimPrefix(b, "v")
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// remoteClientTimeout is the timeout of requests to workload clusters.
+const remoteClientTimeout = 10 * time.Second
+
+// RemoteClientFunc returns a client of the workload cluster of the Cluster
+// with the given name.
+type RemoteClientFunc func(ctx context.Context, c client.Client, cluster client.ObjectKey) (client.Client, error)
+
+// newRemoteClient returns a client of a workload cluster using the kubeconfig
+// in the "<cluster>-kubeconfig" Secret.
+func newRemoteClient(ctx context.Context, c client.Client, cluster client.ObjectKey) (client.Client, error) {
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Name + "-kubeconfig"}
+	if err := c.Get(ctx, key, secret); err != nil {
+		return nil, fmt.Errorf("getting kubeconfig secret: %w", err)
+	}
+	config, err := clientcmd.RESTConfigFromKubeConfig(secret.Data["value"])
+	if err != nil {
+		return nil, fmt.Errorf("parsing kubeconfig secret: %w", err)
+	}
+	config.Timeout = remoteClientTimeout
+	remote, err := client.New(config, client.Options{})
+	if err != nil {
+		return nil, fmt.Errorf("creating workload cluster client: %w", err)
+	}
+	return remote, nil
+}
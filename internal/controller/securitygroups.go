@@ -0,0 +1,208 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+)
+
+// Ports opened between the machines of a cluster.
+const (
+	etcdPortMin  = 2379
+	etcdPortMax  = 2380
+	kubeletPort  = 10250
+	calicoBGP    = 179
+	calicoVXLAN  = 4789
+	calicoTypha  = 5473
+	ciliumVXLAN  = 8472
+	ciliumHealth = 4240
+	ciliumHubble = 4244
+)
+
+// portRule describes traffic allowed from the machines of a cluster.
+type portRule struct {
+	description string
+	protocol    string
+	min, max    int32
+}
+
+// desiredSecurityGroupRules returns the ingress rules of the managed
+// security group of the given role: the traffic Kubernetes and the CNI
+// plugin need between the machines, SSH from the bastion host and the
+// rules of the spec.
+func desiredSecurityGroupRules(
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+	role infrastructurev1alpha1.SecurityGroupRole,
+	controlPlaneID, workerID string,
+) []cloud.SecurityGroupRule {
+	spec := stackitCluster.Spec.SecurityGroups
+
+	fromMachines := []portRule{{description: "kubelet", protocol: "tcp", min: kubeletPort, max: kubeletPort}}
+	fromControlPlane := []portRule(nil)
+	if role == infrastructurev1alpha1.SecurityGroupControlPlane {
+		port := apiServerPort(stackitCluster)
+		fromMachines = append(fromMachines, portRule{description: "Kubernetes API", protocol: "tcp", min: port, max: port})
+		fromControlPlane = append(fromControlPlane, portRule{description: "etcd", protocol: "tcp", min: etcdPortMin, max: etcdPortMax})
+	}
+	fromMachines = append(fromMachines, cniPortRules(spec.CNI)...)
+
+	var rules []cloud.SecurityGroupRule
+	add := func(rule cloud.SecurityGroupRule) {
+		if !slices.ContainsFunc(rules, func(have cloud.SecurityGroupRule) bool { return sameRule(have, rule) }) {
+			rules = append(rules, rule)
+		}
+	}
+	if spec.AllowAllInClusterTraffic {
+		add(cloud.SecurityGroupRule{Description: "Control plane machines", Direction: cloud.DirectionIngress, RemoteSecurityGroupID: controlPlaneID})
+		add(cloud.SecurityGroupRule{Description: "Worker machines", Direction: cloud.DirectionIngress, RemoteSecurityGroupID: workerID})
+	}
+	for _, remote := range []struct {
+		groupID string
+		ports   []portRule
+	}{
+		{controlPlaneID, append(slices.Clone(fromMachines), fromControlPlane...)},
+		{workerID, fromMachines},
+	} {
+		for _, port := range remote.ports {
+			rule := cloud.SecurityGroupRule{
+				Description:           port.description,
+				Direction:             cloud.DirectionIngress,
+				Protocol:              port.protocol,
+				PortRangeMin:          port.min,
+				PortRangeMax:          port.max,
+				RemoteSecurityGroupID: remote.groupID,
+			}
+			add(rule)
+		}
+	}
+	if stackitCluster.Spec.Bastion != nil && stackitCluster.Status.Bastion != nil && stackitCluster.Status.Bastion.SecurityGroupID != "" {
+		add(sshFromBastionRule(stackitCluster.Status.Bastion.SecurityGroupID))
+	}
+
+	specRules := spec.Worker
+	if role == infrastructurev1alpha1.SecurityGroupControlPlane {
+		specRules = spec.ControlPlane
+		if specRules == nil {
+			specRules = infrastructurev1alpha1.DefaultControlPlaneSecurityGroupRules(apiServerPort(stackitCluster))
+		}
+	}
+	for _, rule := range securityGroupRules(specRules) {
+		add(rule)
+	}
+	return rules
+}
+
+// cniPortRules returns the traffic the CNI plugin needs between the
+// machines.
+func cniPortRules(cni infrastructurev1alpha1.CNIPlugin) []portRule {
+	switch cni {
+	case infrastructurev1alpha1.CNINone:
+		return nil
+	case infrastructurev1alpha1.CNICilium:
+		return []portRule{
+			{description: "Cilium VXLAN", protocol: "udp", min: ciliumVXLAN, max: ciliumVXLAN},
+			{description: "Cilium health checks", protocol: "tcp", min: ciliumHealth, max: ciliumHealth},
+			{description: "Cilium health checks", protocol: "icmp"},
+			{description: "Cilium Hubble", protocol: "tcp", min: ciliumHubble, max: ciliumHubble},
+		}
+	default:
+		return []portRule{
+			{description: "Calico BGP", protocol: "tcp", min: calicoBGP, max: calicoBGP},
+			{description: "Calico VXLAN", protocol: "udp", min: calicoVXLAN, max: calicoVXLAN},
+			{description: "Calico Typha", protocol: "tcp", min: calicoTypha, max: calicoTypha},
+		}
+	}
+}
+
+// sshFromBastionRule returns the rule allowing SSH from the bastion host
+// with the given security group.
+func sshFromBastionRule(bastionGroupID string) cloud.SecurityGroupRule {
+	return cloud.SecurityGroupRule{
+		Description:           "SSH from the bastion host",
+		Direction:             cloud.DirectionIngress,
+		Protocol:              "tcp",
+		PortRangeMin:          sshPort,
+		PortRangeMax:          sshPort,
+		RemoteSecurityGroupID: bastionGroupID,
+	}
+}
+
+// syncSecurityGroupRules removes the ingress rules of group not in want and
+// adds the missing ones. Egress rules are left to the STACKIT defaults.
+func syncSecurityGroupRules(ctx context.Context, stackit cloud.Interface, group *cloud.SecurityGroup, want []cloud.SecurityGroupRule) error {
+	log := logf.FromContext(ctx)
+	for _, have := range group.Rules {
+		if have.Direction != cloud.DirectionIngress || slices.ContainsFunc(want, func(rule cloud.SecurityGroupRule) bool {
+			return sameRule(have, rule)
+		}) {
+			continue
+		}
+		log.Info("Removing unexpected security group rule", "securityGroupID", group.ID, "ruleID", have.ID,
+			"description", have.Description)
+		if err := stackit.SecurityGroups().DeleteRule(ctx, group.ID, have.ID); err != nil && !cloud.IsNotFound(err) {
+			return fmt.Errorf("removing rule %s: %w", have.ID, err)
+		}
+	}
+	for _, rule := range want {
+		if slices.ContainsFunc(group.Rules, func(have cloud.SecurityGroupRule) bool { return sameRule(have, rule) }) {
+			continue
+		}
+		if _, err := stackit.SecurityGroups().CreateRule(ctx, group.ID, rule); err != nil {
+			return fmt.Errorf("adding rule %q: %w", rule.Description, err)
+		}
+	}
+	return nil
+}
+
+// securityGroupRules returns the STACKIT security group rules for the given
+// ingress rules of the spec.
+func securityGroupRules(rules []infrastructurev1alpha1.SecurityGroupRule) []cloud.SecurityGroupRule {
+	out := make([]cloud.SecurityGroupRule, 0, len(rules))
+	for _, rule := range rules {
+		r := cloud.SecurityGroupRule{
+			Description:    rule.Description,
+			Direction:      cloud.DirectionIngress,
+			Protocol:       rule.Protocol,
+			RemoteIPPrefix: rule.RemoteIPPrefix,
+		}
+		if r.RemoteIPPrefix == "" {
+			r.RemoteIPPrefix = "0.0.0.0/0"
+		}
+		if rule.Protocol != "icmp" {
+			r.PortRangeMin, r.PortRangeMax = rule.PortRangeMin, rule.PortRangeMax
+			if r.PortRangeMax == 0 {
+				r.PortRangeMax = r.PortRangeMin
+			}
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// sameRule returns true if a and b match the same traffic.
+func sameRule(a, b cloud.SecurityGroupRule) bool {
+	a.ID, b.ID = "", ""
+	a.Description, b.Description = "", ""
+	return a == b
+}
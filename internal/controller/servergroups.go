@@ -0,0 +1,251 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	clusterv1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/capi/v1beta1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+)
+
+// controlPlaneServerGroupName returns the name of the server group of the
+// control plane machines of stackitCluster.
+func controlPlaneServerGroupName(stackitCluster *infrastructurev1alpha1.StackitCluster) string {
+	return resourceName(stackitCluster, infrastructurev1alpha1.ControlPlaneServerGroupName)
+}
+
+// serverGroupPolicy returns the STACKIT policy of a server group, defaulting
+// to anti-affinity.
+func serverGroupPolicy(policy infrastructurev1alpha1.ServerGroupPolicy) string {
+	if policy == "" {
+		return cloud.ServerGroupPolicyAntiAffinity
+	}
+	return string(policy)
+}
+
+// findServerGroup returns the server group with the given name, or nil if it
+// does not exist. Server groups have no labels, so they are identified by
+// their name, which is unique per cluster.
+func findServerGroup(ctx context.Context, stackit cloud.Interface, name string) (*cloud.ServerGroup, error) {
+	groups, err := stackit.ServerGroups().List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing server groups: %w", err)
+	}
+	var found *cloud.ServerGroup
+	for i := range groups {
+		if groups[i].Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("found several server groups named %s", name)
+		}
+		found = &groups[i]
+	}
+	return found, nil
+}
+
+// ensureServerGroup returns the server group with the given name, creating
+// it if it does not exist. The policy of a server group cannot be changed,
+// so an existing group with another policy is an error.
+func ensureServerGroup(ctx context.Context, stackit cloud.Interface, name, policy string) (*cloud.ServerGroup, error) {
+	group, err := findServerGroup(ctx, stackit, name)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		group, err = stackit.ServerGroups().Create(ctx, cloud.CreateServerGroupRequest{Name: name, Policy: policy})
+		if err != nil {
+			return nil, fmt.Errorf("creating server group %s: %w", name, err)
+		}
+		logf.FromContext(ctx).Info("Created server group", "serverGroupID", group.ID, "name", name, "policy", policy)
+		return group, nil
+	}
+	if group.Policy != policy {
+		return nil, fmt.Errorf("server group %s has policy %s instead of %s", name, group.Policy, policy)
+	}
+	return group, nil
+}
+
+// reconcileControlPlaneServerGroup creates the server group of the control
+// plane machines unless it is disabled. A disabled server group is kept until
+// the cluster is deleted, as its members cannot leave it.
+func (r *StackitClusterReconciler) reconcileControlPlaneServerGroup(
+	ctx context.Context,
+	stackit cloud.Interface,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+) error {
+	spec := stackitCluster.Spec.ControlPlaneServerGroup
+	if spec.Disabled {
+		stackitCluster.Status.ControlPlaneServerGroupID = ""
+		return nil
+	}
+	group, err := ensureServerGroup(ctx, stackit, controlPlaneServerGroupName(stackitCluster), serverGroupPolicy(spec.Policy))
+	if err != nil {
+		return err
+	}
+	stackitCluster.Status.ControlPlaneServerGroupID = group.ID
+	return nil
+}
+
+// deleteControlPlaneServerGroup deletes the server group of the control
+// plane machines. It is looked up by name even without status, as it may
+// have been created before the status could be written.
+func (r *StackitClusterReconciler) deleteControlPlaneServerGroup(
+	ctx context.Context,
+	stackit cloud.Interface,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+) error {
+	group, err := findServerGroup(ctx, stackit, controlPlaneServerGroupName(stackitCluster))
+	if err != nil {
+		return err
+	}
+	if group != nil {
+		logf.FromContext(ctx).Info("Deleting server group", "serverGroupID", group.ID)
+		if err := stackit.ServerGroups().Delete(ctx, group.ID); err != nil && !cloud.IsNotFound(err) {
+			return fmt.Errorf("deleting server group %s: %w", group.ID, err)
+		}
+	}
+	stackitCluster.Status.ControlPlaneServerGroupID = ""
+	return nil
+}
+
+// machineServerGroup returns the ID of the server group the server of
+// stackitMachine is created in, or an empty string. A server group named in
+// the spec is created if it does not exist.
+func machineServerGroup(
+	ctx context.Context,
+	stackit cloud.Interface,
+	machine *clusterv1.Machine,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+	stackitMachine *infrastructurev1alpha1.StackitMachine,
+) (string, error) {
+	spec := stackitMachine.Spec.ServerGroup
+	switch {
+	case spec == nil:
+		// The cluster records the server group before its infrastructure
+		// becomes ready, and clears it when the group is disabled.
+		if !isControlPlaneMachine(machine) {
+			return "", nil
+		}
+		return stackitCluster.Status.ControlPlaneServerGroupID, nil
+	case spec.ID != "":
+		group, err := stackit.ServerGroups().Get(ctx, spec.ID)
+		if err != nil {
+			return "", fmt.Errorf("getting server group %s: %w", spec.ID, err)
+		}
+		return group.ID, nil
+	default:
+		group, err := ensureServerGroup(ctx, stackit, resourceName(stackitCluster, spec.Name), serverGroupPolicy(spec.Policy))
+		if err != nil {
+			return "", err
+		}
+		return group.ID, nil
+	}
+}
+
+// deleteMachineServerGroup deletes the server group named in the spec of
+// stackitMachine once its last member is gone and no other StackitMachine of
+// the cluster names it, as these may have created or looked up the group
+// without having created their server in it yet. Server groups referenced by
+// ID and the control plane server group are kept.
+func (r *StackitMachineReconciler) deleteMachineServerGroup(
+	ctx context.Context,
+	stackit cloud.Interface,
+	cluster *clusterv1.Cluster,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+	stackitMachine *infrastructurev1alpha1.StackitMachine,
+) error {
+	log := logf.FromContext(ctx)
+	spec := stackitMachine.Spec.ServerGroup
+	if spec == nil || spec.Name == "" {
+		return nil
+	}
+
+	inUse, err := r.serverGroupNamedByOthers(ctx, cluster, stackitMachine)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		log.Info("Keeping server group named by other machines", "serverGroup", spec.Name)
+		stackitMachine.Status.ServerGroupID = ""
+		return nil
+	}
+
+	// The group may have been created before the server, whose group is
+	// recorded in the status.
+	var group *cloud.ServerGroup
+	if id := stackitMachine.Status.ServerGroupID; id != "" {
+		group, err = stackit.ServerGroups().Get(ctx, id)
+		if cloud.IsNotFound(err) {
+			group, err = nil, nil
+		}
+		if err != nil {
+			return fmt.Errorf("getting server group %s: %w", id, err)
+		}
+	} else if group, err = findServerGroup(ctx, stackit, resourceName(stackitCluster, spec.Name)); err != nil {
+		return err
+	}
+	// The servers of other machines may still be in the server group.
+	if group != nil && len(group.MemberIDs) == 0 {
+		log.Info("Deleting server group", "serverGroupID", group.ID)
+		if err := stackit.ServerGroups().Delete(ctx, group.ID); err != nil && !cloud.IsNotFound(err) {
+			return fmt.Errorf("deleting server group %s: %w", group.ID, err)
+		}
+	}
+	stackitMachine.Status.ServerGroupID = ""
+	return nil
+}
+
+// serverGroupNamedByOthers returns true if a StackitMachine of cluster other
+// than stackitMachine, which is not being deleted, names the same server
+// group in its spec.
+func (r *StackitMachineReconciler) serverGroupNamedByOthers(
+	ctx context.Context,
+	cluster *clusterv1.Cluster,
+	stackitMachine *infrastructurev1alpha1.StackitMachine,
+) (bool, error) {
+	machines := &clusterv1.MachineList{}
+	if err := r.List(ctx, machines, client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name}); err != nil {
+		return false, fmt.Errorf("listing Machines: %w", err)
+	}
+	for i := range machines.Items {
+		ref := machines.Items[i].Spec.InfrastructureRef
+		if !isInfrastructureRef(&ref, "StackitMachine") || ref.Name == stackitMachine.Name {
+			continue
+		}
+		other := &infrastructurev1alpha1.StackitMachine{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: ref.Name}, other); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return false, fmt.Errorf("getting StackitMachine %s: %w", ref.Name, err)
+		}
+		if other.DeletionTimestamp.IsZero() && other.Spec.ServerGroup != nil &&
+			other.Spec.ServerGroup.Name == stackitMachine.Spec.ServerGroup.Name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -18,46 +18,664 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	clusterv1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/capi/v1beta1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/conditions"
 )
 
+// apiServerTargetPool is the name of the load balancer target pool holding
+// the control plane machines.
+const apiServerTargetPool = "control-plane"
+
 // StackitClusterReconciler reconciles a StackitCluster object
 type StackitClusterReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// CloudFactory creates the STACKIT clients used to manage the infrastructure.
+	CloudFactory cloud.Factory
 }
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitclusters,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitclusters/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitclusters/finalizers,verbs=update
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitclusteridentities,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets;namespaces,verbs=get;list;watch
+
+// Reconcile creates the network, security groups and API server load
+// balancer shared by the machines of a cluster, as well as the optional
+// bastion host, and deletes them in reverse order when the StackitCluster
+// is deleted.
+func (r *StackitClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := logf.FromContext(ctx)
+
+	stackitCluster := &infrastructurev1alpha1.StackitCluster{}
+	if err := r.Get(ctx, req.NamespacedName, stackitCluster); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// The owning Cluster may already be gone when the StackitCluster is
+	// deleted, so it is only required for provisioning.
+	deleting := !stackitCluster.DeletionTimestamp.IsZero()
+	cluster, err := getOwnerCluster(ctx, r.Client, stackitCluster.ObjectMeta)
+	if err != nil && (!deleting || !apierrors.IsNotFound(err)) {
+		return ctrl.Result{}, err
+	}
+	if cluster == nil && !deleting {
+		log.Info("Waiting for the Cluster controller to set the owner reference")
+		return ctrl.Result{}, nil
+	}
+	if cluster != nil {
+		log = log.WithValues("cluster", cluster.Name)
+		ctx = logf.IntoContext(ctx, log)
+	}
+	if isPaused(cluster, stackitCluster) {
+		log.Info("Reconciliation is paused")
+		return ctrl.Result{}, nil
+	}
+
+	before := stackitCluster.DeepCopy()
+	defer func() {
+		conditions.SetSummary(stackitCluster,
+			infrastructurev1alpha1.NetworkReadyCondition,
+			infrastructurev1alpha1.SecurityGroupsReadyCondition,
+			infrastructurev1alpha1.LoadBalancerReadyCondition,
+		)
+		if err := patchObject(ctx, r.Client, before, stackitCluster); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	stackit, err := newCloudClient(ctx, r.Client, r.CloudFactory, stackitCluster)
+	if err != nil && deleting {
+		return ctrl.Result{}, markDeletionBlocked(stackitCluster, infrastructurev1alpha1.NetworkReadyCondition,
+			infrastructurev1alpha1.ClusterFinalizer, err)
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if deleting {
+		return r.reconcileDelete(ctx, stackit, stackitCluster)
+	}
+	return r.reconcileNormal(ctx, stackit, stackitCluster)
+}
+
+func (r *StackitClusterReconciler) reconcileNormal(
+	ctx context.Context,
+	stackit cloud.Interface,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+) (ctrl.Result, error) {
+	controllerutil.AddFinalizer(stackitCluster, infrastructurev1alpha1.ClusterFinalizer)
+
+	if err := r.reconcileFailureDomains(ctx, stackit, stackitCluster); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	ready, err := r.reconcileNetwork(ctx, stackit, stackitCluster)
+	if err != nil {
+		conditions.MarkFalse(stackitCluster, infrastructurev1alpha1.NetworkReadyCondition,
+			infrastructurev1alpha1.NetworkReconciliationFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+		return ctrl.Result{}, err
+	}
+	if !ready {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+	conditions.MarkTrue(stackitCluster, infrastructurev1alpha1.NetworkReadyCondition)
+
+	if err := r.reconcileSecurityGroups(ctx, stackit, stackitCluster); err != nil {
+		conditions.MarkFalse(stackitCluster, infrastructurev1alpha1.SecurityGroupsReadyCondition,
+			infrastructurev1alpha1.SecurityGroupsReconciliationFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+		return ctrl.Result{}, err
+	}
+	conditions.MarkTrue(stackitCluster, infrastructurev1alpha1.SecurityGroupsReadyCondition)
+
+	if err := r.reconcileControlPlaneServerGroup(ctx, stackit, stackitCluster); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	ready, err = r.reconcileLoadBalancer(ctx, stackit, stackitCluster)
+	if err != nil {
+		conditions.MarkFalse(stackitCluster, infrastructurev1alpha1.LoadBalancerReadyCondition,
+			infrastructurev1alpha1.LoadBalancerReconciliationFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+		return ctrl.Result{}, err
+	}
+	if !ready {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+	conditions.MarkTrue(stackitCluster, infrastructurev1alpha1.LoadBalancerReadyCondition)
+
+	// The bastion host does not affect the readiness of the cluster.
+	stackitCluster.Status.Ready = true
+
+	if stackitCluster.Spec.Bastion == nil {
+		if stackitCluster.Status.Bastion == nil {
+			return ctrl.Result{}, nil
+		}
+		conditions.MarkFalse(stackitCluster, infrastructurev1alpha1.BastionReadyCondition,
+			infrastructurev1alpha1.DeletingReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+		deleted, err := r.deleteBastion(ctx, stackit, stackitCluster)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !deleted {
+			return ctrl.Result{RequeueAfter: requeueAfter}, nil
+		}
+		return ctrl.Result{}, nil
+	}
+	ready, err = r.reconcileBastion(ctx, stackit, stackitCluster)
+	if err != nil {
+		conditions.MarkFalse(stackitCluster, infrastructurev1alpha1.BastionReadyCondition,
+			infrastructurev1alpha1.BastionReconciliationFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+		return ctrl.Result{}, err
+	}
+	if !ready {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+	conditions.MarkTrue(stackitCluster, infrastructurev1alpha1.BastionReadyCondition)
+	return ctrl.Result{}, nil
+}
+
+// reconcileFailureDomains sets the failure domains of the cluster to the
+// availability zones of its region, restricted to the ones of the spec.
+func (r *StackitClusterReconciler) reconcileFailureDomains(
+	ctx context.Context,
+	stackit cloud.Interface,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+) error {
+	zones, err := stackit.AvailabilityZones().List(ctx)
+	if err != nil {
+		return fmt.Errorf("listing availability zones: %w", err)
+	}
+	if allowed := stackitCluster.Spec.FailureDomains; len(allowed) > 0 {
+		for _, zone := range allowed {
+			if !slices.Contains(zones, zone) {
+				return fmt.Errorf("availability zone %q does not exist in the region of the cluster", zone)
+			}
+		}
+		zones = allowed
+	}
+
+	failureDomains := infrastructurev1alpha1.FailureDomains{}
+	for _, zone := range zones {
+		failureDomains[zone] = infrastructurev1alpha1.FailureDomainSpec{
+			ControlPlane: !isMetroAvailabilityZone(zone),
+		}
+	}
+	stackitCluster.Status.FailureDomains = failureDomains
+	return nil
+}
+
+// isMetroAvailabilityZone returns true for metro availability zones like
+// "eu01-m", which span the sites of the other zones of a region. Spreading
+// control plane machines across them does not protect against site outages.
+func isMetroAvailabilityZone(zone string) bool {
+	return strings.HasSuffix(zone, "-m")
+}
+
+// reconcileNetwork looks up or creates the network of the cluster. Created
+// networks are routed, which provides outgoing NAT for the machines. Existing
+// networks referenced by the spec are marked as unmanaged.
+func (r *StackitClusterReconciler) reconcileNetwork(
+	ctx context.Context,
+	stackit cloud.Interface,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+) (bool, error) {
+	log := logf.FromContext(ctx)
+
+	var network *cloud.Network
+	if id := stackitCluster.Spec.Network.ID; id != "" {
+		var err error
+		network, err = stackit.Networks().Get(ctx, id)
+		if err != nil {
+			return false, fmt.Errorf("getting network %s: %w", id, err)
+		}
+	} else {
+		networks, err := stackit.Networks().List(ctx, clusterLabels(stackitCluster))
+		if err != nil {
+			return false, fmt.Errorf("listing networks: %w", err)
+		}
+		switch len(networks) {
+		case 0:
+			network, err = stackit.Networks().Create(ctx, cloud.CreateNetworkRequest{
+				Name:        resourceName(stackitCluster, "network"),
+				IPv4Prefix:  stackitCluster.Spec.Network.CIDR,
+				NameServers: stackitCluster.Spec.Network.NameServers,
+				Routed:      true,
+				Labels:      clusterLabels(stackitCluster),
+			})
+			if err != nil {
+				return false, fmt.Errorf("creating network: %w", err)
+			}
+			log.Info("Created network", "networkID", network.ID)
+		case 1:
+			network = &networks[0]
+		default:
+			return false, fmt.Errorf("found %d networks labeled %s=%s", len(networks), cloud.ClusterUIDLabel, stackitCluster.UID)
+		}
+	}
+
+	status := &infrastructurev1alpha1.NetworkStatus{
+		ID:         network.ID,
+		NATAddress: network.PublicIP,
+		Unmanaged:  stackitCluster.Spec.Network.ID != "",
+	}
+	if prefix := stackitCluster.Spec.Network.Prefix; prefix != "" {
+		if !slices.Contains(network.Prefixes, prefix) {
+			return false, fmt.Errorf("network %s has no prefix %s", network.ID, prefix)
+		}
+		status.CIDR = prefix
+	} else if len(network.Prefixes) > 0 {
+		status.CIDR = network.Prefixes[0]
+	}
+	stackitCluster.Status.Network = status
+
+	if network.Status != cloud.NetworkStatusCreated {
+		log.Info("Waiting for the network to be created", "networkID", network.ID, "state", network.Status)
+		conditions.MarkFalse(stackitCluster, infrastructurev1alpha1.NetworkReadyCondition,
+			infrastructurev1alpha1.NetworkProvisioningReason, infrastructurev1alpha1.ConditionSeverityInfo,
+			"Network %s is in state %s", network.ID, network.Status)
+		return false, nil
+	}
+	return true, nil
+}
+
+// reconcileSecurityGroups looks up or creates the control plane and worker
+// security groups and reconciles their ingress rules. Existing security
+// groups referenced by the spec are used as they are.
+func (r *StackitClusterReconciler) reconcileSecurityGroups(
+	ctx context.Context,
+	stackit cloud.Interface,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+) error {
+	log := logf.FromContext(ctx)
 
-// Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the StackitCluster object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
-//
-// For more details, check Reconcile and its Result here:
-// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.21.0/pkg/reconcile
-func (r *StackitClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = logf.FromContext(ctx)
+	roles := []infrastructurev1alpha1.SecurityGroupRole{
+		infrastructurev1alpha1.SecurityGroupControlPlane,
+		infrastructurev1alpha1.SecurityGroupWorker,
+	}
+	existingIDs := map[infrastructurev1alpha1.SecurityGroupRole]string{
+		infrastructurev1alpha1.SecurityGroupControlPlane: stackitCluster.Spec.SecurityGroups.ControlPlaneID,
+		infrastructurev1alpha1.SecurityGroupWorker:       stackitCluster.Spec.SecurityGroups.WorkerID,
+	}
+	groups := map[infrastructurev1alpha1.SecurityGroupRole]*cloud.SecurityGroup{}
+	statuses := map[infrastructurev1alpha1.SecurityGroupRole]infrastructurev1alpha1.SecurityGroupStatus{}
+	for _, role := range roles {
+		if id := existingIDs[role]; id != "" {
+			group, err := stackit.SecurityGroups().Get(ctx, id)
+			if err != nil {
+				return fmt.Errorf("getting %s security group %s: %w", role, id, err)
+			}
+			groups[role] = group
+			statuses[role] = infrastructurev1alpha1.SecurityGroupStatus{ID: group.ID, Name: group.Name, Unmanaged: true}
+			continue
+		}
 
-	// TODO(user): your logic here
+		labels := clusterLabels(stackitCluster)
+		labels[cloud.RoleLabel] = string(role)
+		existing, err := stackit.SecurityGroups().List(ctx, labels)
+		if err != nil {
+			return fmt.Errorf("listing security groups: %w", err)
+		}
 
+		var group *cloud.SecurityGroup
+		switch len(existing) {
+		case 0:
+			group, err = stackit.SecurityGroups().Create(ctx, cloud.CreateSecurityGroupRequest{
+				Name:        resourceName(stackitCluster, string(role)),
+				Description: fmt.Sprintf("Kubernetes cluster %s/%s %s machines", stackitCluster.Namespace, stackitCluster.Name, role),
+				Stateful:    true,
+				Labels:      labels,
+			})
+			if err != nil {
+				return fmt.Errorf("creating %s security group: %w", role, err)
+			}
+			log.Info("Created security group", "securityGroupID", group.ID, "role", role)
+		case 1:
+			group = &existing[0]
+		default:
+			return fmt.Errorf("found %d %s security groups for the cluster", len(existing), role)
+		}
+		groups[role] = group
+		statuses[role] = infrastructurev1alpha1.SecurityGroupStatus{ID: group.ID, Name: group.Name}
+	}
+	stackitCluster.Status.SecurityGroups = statuses
+
+	controlPlaneID := groups[infrastructurev1alpha1.SecurityGroupControlPlane].ID
+	workerID := groups[infrastructurev1alpha1.SecurityGroupWorker].ID
+	for _, role := range roles {
+		if statuses[role].Unmanaged {
+			continue
+		}
+		want := desiredSecurityGroupRules(stackitCluster, role, controlPlaneID, workerID)
+		if err := syncSecurityGroupRules(ctx, stackit, groups[role], want); err != nil {
+			return fmt.Errorf("reconciling rules of %s security group: %w", role, err)
+		}
+	}
+	return nil
+}
+
+// reconcileLoadBalancer looks up or creates the API server load balancer,
+// updates its configuration to match the spec and sets the control plane
+// endpoint to its address. The targets of the load balancer are managed by
+// the StackitMachine reconciler. Existing load balancers referenced by the
+// spec are used as they are.
+func (r *StackitClusterReconciler) reconcileLoadBalancer(
+	ctx context.Context,
+	stackit cloud.Interface,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+) (bool, error) {
+	log := logf.FromContext(ctx)
+
+	name := apiServerLoadBalancerName(stackitCluster)
+	unmanaged := stackitCluster.Spec.ControlPlaneLoadBalancer.Name != ""
+	desired := apiServerLoadBalancer(stackitCluster, name)
+	lb, err := stackit.LoadBalancers().Get(ctx, name)
+	switch {
+	case unmanaged:
+		if err != nil {
+			return false, fmt.Errorf("getting load balancer %s: %w", name, err)
+		}
+	case cloud.IsNotFound(err):
+		lb, err = stackit.LoadBalancers().Create(ctx, desired)
+		if err != nil {
+			return false, fmt.Errorf("creating load balancer %s: %w", name, err)
+		}
+		log.Info("Created API server load balancer", "loadBalancer", name)
+	case err != nil:
+		return false, fmt.Errorf("getting load balancer %s: %w", name, err)
+	case lb.Labels[cloud.ClusterUIDLabel] != string(stackitCluster.UID):
+		return false, fmt.Errorf("load balancer %s exists but does not belong to the cluster", name)
+	case lb.Status != cloud.LoadBalancerStatusTerminating && !loadBalancerUpToDate(lb, &desired):
+		for _, pool := range lb.TargetPools {
+			if pool.Name == apiServerTargetPool {
+				desired.TargetPools[0].Targets = pool.Targets
+			}
+		}
+		lb, err = stackit.LoadBalancers().Update(ctx, desired)
+		if err != nil {
+			return false, fmt.Errorf("updating load balancer %s: %w", name, err)
+		}
+		log.Info("Updated API server load balancer", "loadBalancer", name)
+	}
+
+	stackitCluster.Status.APIServerLoadBalancer = &infrastructurev1alpha1.LoadBalancerStatus{
+		Name:            lb.Name,
+		ExternalAddress: lb.ExternalAddress,
+		PrivateAddress:  lb.PrivateAddress,
+		Unmanaged:       unmanaged,
+	}
+
+	switch lb.Status {
+	case cloud.LoadBalancerStatusReady:
+	case cloud.LoadBalancerStatusError:
+		log.Info("API server load balancer has failed", "loadBalancer", name, "errors", lb.Errors)
+		conditions.MarkFalse(stackitCluster, infrastructurev1alpha1.LoadBalancerReadyCondition,
+			infrastructurev1alpha1.LoadBalancerFailedReason, infrastructurev1alpha1.ConditionSeverityError,
+			"Load balancer %s is in state %s: %s", name, lb.Status, strings.Join(lb.Errors, "; "))
+		return false, nil
+	default:
+		log.Info("Waiting for the API server load balancer to be ready", "loadBalancer", name, "state", lb.Status)
+		conditions.MarkFalse(stackitCluster, infrastructurev1alpha1.LoadBalancerReadyCondition,
+			infrastructurev1alpha1.LoadBalancerProvisioningReason, infrastructurev1alpha1.ConditionSeverityInfo,
+			"Load balancer %s is in state %s", name, lb.Status)
+		return false, nil
+	}
+
+	if !stackitCluster.Spec.ControlPlaneEndpoint.IsValid() {
+		host := lb.ExternalAddress
+		if host == "" {
+			host = lb.PrivateAddress
+		}
+		if host == "" {
+			log.Info("Waiting for the API server load balancer address", "loadBalancer", name)
+			conditions.MarkFalse(stackitCluster, infrastructurev1alpha1.LoadBalancerReadyCondition,
+				infrastructurev1alpha1.LoadBalancerProvisioningReason, infrastructurev1alpha1.ConditionSeverityInfo,
+				"Load balancer %s has no address yet", name)
+			return false, nil
+		}
+		stackitCluster.Spec.ControlPlaneEndpoint = infrastructurev1alpha1.APIEndpoint{
+			Host: host,
+			Port: apiServerPort(stackitCluster),
+		}
+	}
+	return true, nil
+}
+
+// reconcileDelete deletes the load balancer, the security groups and the
+// network of the cluster, each once the previous ones are gone.
+func (r *StackitClusterReconciler) reconcileDelete(
+	ctx context.Context,
+	stackit cloud.Interface,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	stackitCluster.Status.Ready = false
+	for _, t := range []infrastructurev1alpha1.ConditionType{
+		infrastructurev1alpha1.NetworkReadyCondition,
+		infrastructurev1alpha1.SecurityGroupsReadyCondition,
+		infrastructurev1alpha1.LoadBalancerReadyCondition,
+	} {
+		conditions.MarkFalse(stackitCluster, t, infrastructurev1alpha1.DeletingReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+	}
+
+	if stackitCluster.Spec.Bastion != nil {
+		conditions.MarkFalse(stackitCluster, infrastructurev1alpha1.BastionReadyCondition,
+			infrastructurev1alpha1.DeletingReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+	}
+
+	// The bastion host is looked up by its labels even without status, as
+	// it may have been created before the status could be written.
+	deleted, err := r.deleteBastion(ctx, stackit, stackitCluster)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !deleted {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	// Only the load balancer created for the cluster is deleted, never one
+	// referenced by the spec.
+	name := resourceName(stackitCluster, "kubeapi")
+	lb, err := stackit.LoadBalancers().Get(ctx, name)
+	switch {
+	case cloud.IsNotFound(err):
+		stackitCluster.Status.APIServerLoadBalancer = nil
+	case err != nil:
+		return ctrl.Result{}, fmt.Errorf("getting load balancer %s: %w", name, err)
+	case lb.Labels[cloud.ClusterUIDLabel] != string(stackitCluster.UID):
+		stackitCluster.Status.APIServerLoadBalancer = nil
+	default:
+		if lb.Status != cloud.LoadBalancerStatusTerminating {
+			log.Info("Deleting API server load balancer", "loadBalancer", name)
+			if err := stackit.LoadBalancers().Delete(ctx, name); err != nil && !cloud.IsNotFound(err) {
+				return ctrl.Result{}, fmt.Errorf("deleting load balancer %s: %w", name, err)
+			}
+		}
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	groups, err := stackit.SecurityGroups().List(ctx, clusterLabels(stackitCluster))
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("listing security groups: %w", err)
+	}
+	for _, group := range groups {
+		log.Info("Deleting security group", "securityGroupID", group.ID)
+		err := stackit.SecurityGroups().Delete(ctx, group.ID)
+		if cloud.IsConflict(err) {
+			log.Info("Waiting for the security group to be unused", "securityGroupID", group.ID)
+			return ctrl.Result{RequeueAfter: requeueAfter}, nil
+		}
+		if err != nil && !cloud.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("deleting security group %s: %w", group.ID, err)
+		}
+	}
+	stackitCluster.Status.SecurityGroups = nil
+
+	if err := r.deleteControlPlaneServerGroup(ctx, stackit, stackitCluster); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	networks, err := stackit.Networks().List(ctx, clusterLabels(stackitCluster))
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("listing networks: %w", err)
+	}
+	for _, network := range networks {
+		if network.Status == cloud.NetworkStatusDeleting {
+			continue
+		}
+		log.Info("Deleting network", "networkID", network.ID)
+		err := stackit.Networks().Delete(ctx, network.ID)
+		if cloud.IsConflict(err) {
+			log.Info("Waiting for the network to be unused", "networkID", network.ID)
+			continue
+		}
+		if err != nil && !cloud.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("deleting network %s: %w", network.ID, err)
+		}
+	}
+	if len(networks) > 0 {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+	stackitCluster.Status.Network = nil
+
+	controllerutil.RemoveFinalizer(stackitCluster, infrastructurev1alpha1.ClusterFinalizer)
 	return ctrl.Result{}, nil
 }
 
+// clusterLabels returns the labels identifying the STACKIT resources of
+// stackitCluster.
+func clusterLabels(stackitCluster *infrastructurev1alpha1.StackitCluster) map[string]string {
+	return map[string]string{cloud.ClusterUIDLabel: string(stackitCluster.UID)}
+}
+
+// apiServerPort returns the port the API servers are reachable on.
+func apiServerPort(stackitCluster *infrastructurev1alpha1.StackitCluster) int32 {
+	if port := stackitCluster.Spec.ControlPlaneEndpoint.Port; port != 0 {
+		return port
+	}
+	if port := stackitCluster.Spec.ControlPlaneLoadBalancer.Port; port != 0 {
+		return port
+	}
+	return infrastructurev1alpha1.DefaultAPIServerPort
+}
+
+// apiServerLoadBalancerName returns the name of the API server load balancer
+// of stackitCluster.
+func apiServerLoadBalancerName(stackitCluster *infrastructurev1alpha1.StackitCluster) string {
+	if name := stackitCluster.Spec.ControlPlaneLoadBalancer.Name; name != "" {
+		return name
+	}
+	return resourceName(stackitCluster, "kubeapi")
+}
+
+// apiServerLoadBalancer returns the API server load balancer configured by
+// the spec of stackitCluster, without targets.
+func apiServerLoadBalancer(stackitCluster *infrastructurev1alpha1.StackitCluster, name string) cloud.LoadBalancer {
+	spec := stackitCluster.Spec.ControlPlaneLoadBalancer
+	port := apiServerPort(stackitCluster)
+	pool := cloud.TargetPool{
+		Name:       apiServerTargetPool,
+		TargetPort: port,
+	}
+	if hc := spec.HealthCheck; hc != nil {
+		pool.HealthCheck = &cloud.HealthCheck{
+			IntervalSeconds:    hc.IntervalSeconds,
+			TimeoutSeconds:     hc.TimeoutSeconds,
+			HealthyThreshold:   hc.HealthyThreshold,
+			UnhealthyThreshold: hc.UnhealthyThreshold,
+		}
+	}
+	return cloud.LoadBalancer{
+		Name:      name,
+		NetworkID: stackitCluster.Status.Network.ID,
+		Listeners: []cloud.Listener{{
+			Name:       "kubeapi",
+			Port:       port,
+			TargetPool: apiServerTargetPool,
+		}},
+		TargetPools: []cloud.TargetPool{pool},
+		Options: cloud.LoadBalancerOptions{
+			PrivateNetworkOnly:  spec.Type == infrastructurev1alpha1.InternalLoadBalancer,
+			AllowedSourceRanges: spec.AllowedSourceRanges,
+		},
+		Labels: clusterLabels(stackitCluster),
+	}
+}
+
+// loadBalancerUpToDate returns true if the listeners, target pools and
+// options of have match want. Targets are ignored, as are health check
+// settings left to the STACKIT defaults by want.
+func loadBalancerUpToDate(have, want *cloud.LoadBalancer) bool {
+	if have.Options.PrivateNetworkOnly != want.Options.PrivateNetworkOnly ||
+		!slices.Equal(have.Options.AllowedSourceRanges, want.Options.AllowedSourceRanges) {
+		return false
+	}
+	if !slices.EqualFunc(have.Listeners, want.Listeners, func(a, b cloud.Listener) bool {
+		return a.Name == b.Name && a.Port == b.Port && a.TargetPool == b.TargetPool
+	}) {
+		return false
+	}
+	return slices.EqualFunc(have.TargetPools, want.TargetPools, func(a, b cloud.TargetPool) bool {
+		return a.Name == b.Name && a.TargetPort == b.TargetPort && healthCheckUpToDate(a.HealthCheck, b.HealthCheck)
+	})
+}
+
+// healthCheckUpToDate returns true if have sets all fields set by want to
+// the same values.
+func healthCheckUpToDate(have, want *cloud.HealthCheck) bool {
+	if want == nil {
+		return true
+	}
+	if have == nil {
+		return false
+	}
+	matches := func(have, want int32) bool { return want == 0 || have == want }
+	return matches(have.IntervalSeconds, want.IntervalSeconds) &&
+		matches(have.TimeoutSeconds, want.TimeoutSeconds) &&
+		matches(have.HealthyThreshold, want.HealthyThreshold) &&
+		matches(have.UnhealthyThreshold, want.UnhealthyThreshold)
+}
+
+// clusterToStackitCluster maps a Cluster to the StackitCluster referenced by
+// its infrastructureRef.
+func clusterToStackitCluster(_ context.Context, obj client.Object) []reconcile.Request {
+	cluster, ok := obj.(*clusterv1.Cluster)
+	if !ok || !isInfrastructureRef(cluster.Spec.InfrastructureRef, "StackitCluster") {
+		return nil
+	}
+	namespace := cluster.Spec.InfrastructureRef.Namespace
+	if namespace == "" {
+		namespace = cluster.Namespace
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Namespace: namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}}}
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *StackitClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrastructurev1alpha1.StackitCluster{}).
+		Watches(&clusterv1.Cluster{}, handler.EnqueueRequestsFromMapFunc(clusterToStackitCluster)).
 		Named("stackitcluster").
 		Complete(r)
 }
@@ -21,64 +21,656 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-
 	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	clusterv1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/capi/v1beta1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud/stackit"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/conditions"
 )
 
 var _ = Describe("StackitCluster Controller", func() {
 	Context("When reconciling a resource", func() {
-		const resourceName = "test-resource"
+		const (
+			clusterName = "test-resource"
+			projectID   = "00000000-0000-0000-0000-000000000000"
+		)
 
 		ctx := context.Background()
 
 		typeNamespacedName := types.NamespacedName{
-			Name:      resourceName,
-			Namespace: "default", // TODO(user):Modify as needed
+			Name:      clusterName,
+			Namespace: "default",
 		}
-		stackitcluster := &infrastructurev1alpha1.StackitCluster{}
+
+		var reconciler *StackitClusterReconciler
 
 		BeforeEach(func() {
+			reconciler = &StackitClusterReconciler{
+				Client:       k8sClient,
+				Scheme:       k8sClient.Scheme(),
+				CloudFactory: cloudFactory,
+			}
+
+			By("creating the owning Cluster")
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: "default"},
+			}
+			Expect(k8sClient.Create(ctx, cluster)).To(Succeed())
+
 			By("creating the custom resource for the Kind StackitCluster")
-			err := k8sClient.Get(ctx, typeNamespacedName, stackitcluster)
-			if err != nil && errors.IsNotFound(err) {
-				resource := &infrastructurev1alpha1.StackitCluster{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      resourceName,
-						Namespace: "default",
+			resource := &infrastructurev1alpha1.StackitCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      clusterName,
+					Namespace: "default",
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Cluster",
+						Name:       cluster.Name,
+						UID:        cluster.UID,
+					}},
+				},
+				Spec: infrastructurev1alpha1.StackitClusterSpec{
+					ProjectID: projectID,
+					Region:    "eu01",
+					Network: infrastructurev1alpha1.NetworkSpec{
+						CIDR: "10.0.0.0/24",
 					},
-					// TODO(user): Specify other spec details if needed.
-				}
-				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+				},
 			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
 		})
 
 		AfterEach(func() {
-			// TODO(user): Cleanup logic after each test, like removing the resource instance.
 			resource := &infrastructurev1alpha1.StackitCluster{}
 			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			if err == nil {
+				By("Cleanup the specific resource instance StackitCluster")
+				api, err := cloudFactory(ctx, cloud.Scope{ProjectID: projectID, Region: "eu01"})
+				Expect(err).NotTo(HaveOccurred())
+				err = api.LoadBalancers().Delete(ctx, resourceName(resource, "kubeapi"))
+				Expect(err == nil || cloud.IsNotFound(err)).To(BeTrue())
+				group, err := findServerGroup(ctx, api, controlPlaneServerGroupName(resource))
+				Expect(err).NotTo(HaveOccurred())
+				if group != nil {
+					Expect(api.ServerGroups().Delete(ctx, group.ID)).To(Succeed())
+				}
+				controllerutil.RemoveFinalizer(resource, infrastructurev1alpha1.ClusterFinalizer)
+				Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, resource))).To(Succeed())
+			} else {
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+			}
+
+			cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: "default"}}
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, cluster))).To(Succeed())
+		})
+
+		It("should not reconcile a paused resource", func() {
+			resource := &infrastructurev1alpha1.StackitCluster{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Annotations = map[string]string{clusterv1.PausedAnnotation: "true"}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
 			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Finalizers).To(BeEmpty())
+			Expect(resource.Status.Network).To(BeNil())
+		})
 
-			By("Cleanup the specific resource instance StackitCluster")
+		It("should map the Cluster to the StackitCluster", func() {
+			cluster := &clusterv1.Cluster{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, cluster)).To(Succeed())
+			Expect(clusterToStackitCluster(ctx, cluster)).To(BeEmpty())
+
+			cluster.Spec.InfrastructureRef = &corev1.ObjectReference{
+				APIVersion: infrastructurev1alpha1.GroupVersion.String(),
+				Kind:       "StackitCluster",
+				Name:       clusterName,
+			}
+			Expect(clusterToStackitCluster(ctx, cluster)).To(ConsistOf(
+				reconcile.Request{NamespacedName: typeNamespacedName},
+			))
+		})
+
+		It("should read the credentials from the identity Secret", func() {
+			resource := &infrastructurev1alpha1.StackitCluster{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.IdentityRef = &infrastructurev1alpha1.StackitIdentityReference{
+				Kind: infrastructurev1alpha1.SecretIdentityKind,
+				Name: "test-resource-credentials",
+			}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).To(MatchError(ContainSubstring("getting credentials secret")))
+
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-resource-credentials", Namespace: "default"},
+				Data:       map[string][]byte{},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, secret)
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).To(MatchError(ContainSubstring("must be set")))
+
+			secret.Data = map[string][]byte{cloud.TokenSecretKey: []byte("token")}
+			Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+			scope, err := cloudScope(ctx, k8sClient, resource)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scope.Credentials).To(Equal(&cloud.Credentials{Token: "token"}))
+		})
+
+		It("should report a deletion blocked by missing credentials", func() {
+			resource := &infrastructurev1alpha1.StackitCluster{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			controllerutil.AddFinalizer(resource, infrastructurev1alpha1.ClusterFinalizer)
+			resource.Spec.IdentityRef = &infrastructurev1alpha1.StackitIdentityReference{
+				Kind: infrastructurev1alpha1.SecretIdentityKind,
+				Name: "test-resource-credentials",
+			}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
 			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).To(MatchError(ContainSubstring("getting credentials secret")))
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Finalizers).To(ContainElement(infrastructurev1alpha1.ClusterFinalizer))
+			condition := conditions.Get(resource, infrastructurev1alpha1.ReadyCondition)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(infrastructurev1alpha1.DeletionBlockedReason))
+			Expect(condition.Message).To(ContainSubstring("remove the finalizer " + infrastructurev1alpha1.ClusterFinalizer))
+		})
+
+		It("should refuse identities not allowed in the namespace", func() {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-resource-identity", Namespace: "default"},
+				Data:       map[string][]byte{cloud.TokenSecretKey: []byte("token")},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, secret)
+			identity := &infrastructurev1alpha1.StackitClusterIdentity{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-resource-identity"},
+				Spec: infrastructurev1alpha1.StackitClusterIdentitySpec{
+					SecretRef: corev1.SecretReference{Name: secret.Name, Namespace: secret.Namespace},
+				},
+			}
+			Expect(k8sClient.Create(ctx, identity)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, identity)
+
+			resource := &infrastructurev1alpha1.StackitCluster{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.IdentityRef = &infrastructurev1alpha1.StackitIdentityReference{
+				Kind: infrastructurev1alpha1.ClusterIdentityKind,
+				Name: identity.Name,
+			}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			By("refusing an identity without allowed namespaces")
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).To(MatchError(errIdentityNotAllowed))
+
+			By("refusing an identity allowing other namespaces")
+			identity.Spec.AllowedNamespaces = &infrastructurev1alpha1.AllowedNamespaces{
+				NamespaceList: []string{"team-a"},
+				Selector:      &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			}
+			Expect(k8sClient.Update(ctx, identity)).To(Succeed())
+			_, err = cloudScope(ctx, k8sClient, resource)
+			Expect(err).To(MatchError(errIdentityNotAllowed))
+
+			By("allowing the namespace by its labels")
+			identity.Spec.AllowedNamespaces.Selector.MatchLabels = map[string]string{
+				corev1.LabelMetadataName: "default",
+			}
+			Expect(k8sClient.Update(ctx, identity)).To(Succeed())
+			scope, err := cloudScope(ctx, k8sClient, resource)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scope.Credentials).To(Equal(&cloud.Credentials{Token: "token"}))
+
+			By("allowing all namespaces")
+			identity.Spec.AllowedNamespaces = &infrastructurev1alpha1.AllowedNamespaces{}
+			Expect(k8sClient.Update(ctx, identity)).To(Succeed())
+			_, err = cloudScope(ctx, k8sClient, resource)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("refusing an identity without the namespace of its Secret")
+			identity.Spec.SecretRef.Namespace = ""
+			Expect(k8sClient.Update(ctx, identity)).To(Succeed())
+			_, err = cloudScope(ctx, k8sClient, resource)
+			Expect(err).To(MatchError(ContainSubstring("secretRef.namespace must be set")))
 		})
-		It("should successfully reconcile the resource", func() {
+
+		It("should create and delete the cluster infrastructure", func() {
 			By("Reconciling the created resource")
-			controllerReconciler := &StackitClusterReconciler{
-				Client: k8sClient,
-				Scheme: k8sClient.Scheme(),
+			resource := &infrastructurev1alpha1.StackitCluster{}
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+				g.Expect(resource.Status.Ready).To(BeTrue())
+			}).Should(Succeed())
+
+			Expect(resource.Finalizers).To(ContainElement(infrastructurev1alpha1.ClusterFinalizer))
+			for _, t := range []infrastructurev1alpha1.ConditionType{
+				infrastructurev1alpha1.ReadyCondition,
+				infrastructurev1alpha1.NetworkReadyCondition,
+				infrastructurev1alpha1.SecurityGroupsReadyCondition,
+				infrastructurev1alpha1.LoadBalancerReadyCondition,
+			} {
+				Expect(conditions.IsTrue(resource, t)).To(BeTrue(), string(t))
+			}
+			Expect(resource.Status.FailureDomains).To(Equal(infrastructurev1alpha1.FailureDomains{
+				"eu01-1": {ControlPlane: true},
+				"eu01-2": {ControlPlane: true},
+				"eu01-3": {ControlPlane: true},
+			}))
+			Expect(resource.Status.Network).NotTo(BeNil())
+			Expect(resource.Status.Network.CIDR).To(Equal("10.0.0.0/24"))
+			Expect(resource.Status.Network.NATAddress).NotTo(BeEmpty())
+			Expect(resource.Status.SecurityGroups).To(HaveKey(infrastructurev1alpha1.SecurityGroupControlPlane))
+			Expect(resource.Status.SecurityGroups).To(HaveKey(infrastructurev1alpha1.SecurityGroupWorker))
+			Expect(resource.Status.APIServerLoadBalancer).NotTo(BeNil())
+			Expect(resource.Spec.ControlPlaneEndpoint.Host).To(Equal(resource.Status.APIServerLoadBalancer.ExternalAddress))
+			Expect(resource.Spec.ControlPlaneEndpoint.Port).To(BeEquivalentTo(6443))
+
+			api, err := cloudFactory(ctx, cloud.Scope{ProjectID: projectID, Region: "eu01"})
+			Expect(err).NotTo(HaveOccurred())
+			group, err := api.SecurityGroups().Get(ctx, resource.Status.SecurityGroups[infrastructurev1alpha1.SecurityGroupControlPlane].ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Rules).To(ContainElement(HaveField("PortRangeMin", BeEquivalentTo(6443))))
+
+			By("reconciling again without creating duplicates")
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			labels := map[string]string{cloud.ClusterUIDLabel: string(resource.UID)}
+			networks, err := api.Networks().List(ctx, labels)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(networks).To(HaveLen(1))
+			groups, err := api.SecurityGroups().List(ctx, labels)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(groups).To(HaveLen(2))
+
+			By("deleting the resource")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+			}).Should(Succeed())
+
+			networks, err = api.Networks().List(ctx, labels)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(networks).To(BeEmpty())
+			groups, err = api.SecurityGroups().List(ctx, labels)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(groups).To(BeEmpty())
+			_, err = api.LoadBalancers().Get(ctx, resourceName(resource, "kubeapi"))
+			Expect(cloud.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should use existing infrastructure without deleting it", func() {
+			By("pre-provisioning the network, a security group and the load balancer")
+			api, err := cloudFactory(ctx, cloud.Scope{ProjectID: projectID, Region: "eu01"})
+			Expect(err).NotTo(HaveOccurred())
+			network, err := api.Networks().Create(ctx, cloud.CreateNetworkRequest{Name: "existing", IPv4Prefix: "10.1.0.0/24"})
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(func() { Expect(api.Networks().Delete(ctx, network.ID)).To(Succeed()) })
+			group, err := api.SecurityGroups().Create(ctx, cloud.CreateSecurityGroupRequest{Name: "existing"})
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(func() { Expect(api.SecurityGroups().Delete(ctx, group.ID)).To(Succeed()) })
+			lb, err := api.LoadBalancers().Create(ctx, cloud.LoadBalancer{
+				Name:        "existing",
+				NetworkID:   network.ID,
+				Listeners:   []cloud.Listener{{Name: "api", Port: 6443, TargetPool: "api"}},
+				TargetPools: []cloud.TargetPool{{Name: "api", TargetPort: 6443}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(func() { Expect(api.LoadBalancers().Delete(ctx, lb.Name)).To(Succeed()) })
+
+			resource := &infrastructurev1alpha1.StackitCluster{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.Network = infrastructurev1alpha1.NetworkSpec{ID: network.ID, Prefix: "10.1.0.0/24"}
+			resource.Spec.SecurityGroups.WorkerID = group.ID
+			resource.Spec.ControlPlaneLoadBalancer.Name = lb.Name
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			By("reconciling the resource")
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+				g.Expect(resource.Status.Ready).To(BeTrue())
+			}).Should(Succeed())
+			Expect(resource.Status.Network).To(Equal(&infrastructurev1alpha1.NetworkStatus{
+				ID:         network.ID,
+				CIDR:       "10.1.0.0/24",
+				NATAddress: resource.Status.Network.NATAddress,
+				Unmanaged:  true,
+			}))
+			Expect(resource.Status.SecurityGroups[infrastructurev1alpha1.SecurityGroupWorker]).To(Equal(
+				infrastructurev1alpha1.SecurityGroupStatus{ID: group.ID, Name: group.Name, Unmanaged: true}))
+			Expect(resource.Status.SecurityGroups[infrastructurev1alpha1.SecurityGroupControlPlane].Unmanaged).To(BeFalse())
+			Expect(resource.Status.APIServerLoadBalancer).To(HaveField("Name", lb.Name))
+			Expect(resource.Status.APIServerLoadBalancer).To(HaveField("Unmanaged", true))
+			Expect(resource.Spec.ControlPlaneEndpoint.Host).To(Equal(resource.Status.APIServerLoadBalancer.ExternalAddress))
+
+			group, err = api.SecurityGroups().Get(ctx, group.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Rules).To(BeEmpty())
+			_, err = api.LoadBalancers().Get(ctx, resourceName(resource, "kubeapi"))
+			Expect(cloud.IsNotFound(err)).To(BeTrue())
+
+			By("deleting the resource")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+			}).Should(Succeed())
+			_, err = api.Networks().Get(ctx, network.ID)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = api.SecurityGroups().Get(ctx, group.ID)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = api.LoadBalancers().Get(ctx, lb.Name)
+			Expect(err).NotTo(HaveOccurred())
+			groups, err := api.SecurityGroups().List(ctx, map[string]string{cloud.ClusterUIDLabel: string(resource.UID)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(groups).To(BeEmpty())
+		})
+
+		It("should configure and update the API server load balancer", func() {
+			resource := &infrastructurev1alpha1.StackitCluster{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.ControlPlaneLoadBalancer = infrastructurev1alpha1.LoadBalancerSpec{
+				Type:                infrastructurev1alpha1.InternalLoadBalancer,
+				Port:                443,
+				AllowedSourceRanges: []string{"10.0.0.0/8"},
+				HealthCheck:         &infrastructurev1alpha1.HealthCheckSpec{IntervalSeconds: 5, UnhealthyThreshold: 2},
 			}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			By("reconciling the internal load balancer")
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+				g.Expect(resource.Status.Ready).To(BeTrue())
+			}).Should(Succeed())
+			Expect(resource.Status.APIServerLoadBalancer.ExternalAddress).To(BeEmpty())
+			Expect(resource.Spec.ControlPlaneEndpoint.Host).To(Equal(resource.Status.APIServerLoadBalancer.PrivateAddress))
+			Expect(resource.Spec.ControlPlaneEndpoint.Port).To(BeEquivalentTo(443))
+
+			api, err := cloudFactory(ctx, cloud.Scope{ProjectID: projectID, Region: "eu01"})
+			Expect(err).NotTo(HaveOccurred())
+			lb, err := api.LoadBalancers().Get(ctx, resource.Status.APIServerLoadBalancer.Name)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lb.Options).To(Equal(cloud.LoadBalancerOptions{
+				PrivateNetworkOnly:  true,
+				AllowedSourceRanges: []string{"10.0.0.0/8"},
+			}))
+			Expect(lb.Listeners).To(ConsistOf(HaveField("Port", BeEquivalentTo(443))))
+			Expect(lb.TargetPools).To(ConsistOf(And(
+				HaveField("TargetPort", BeEquivalentTo(443)),
+				HaveField("HealthCheck", &cloud.HealthCheck{IntervalSeconds: 5, UnhealthyThreshold: 2}),
+			)))
+
+			By("registering a target as the StackitMachine reconciler does")
+			target := cloud.Target{DisplayName: "control-plane-0", IP: "10.0.0.10"}
+			lb.TargetPools[0].Targets = []cloud.Target{target}
+			_, err = api.LoadBalancers().Update(ctx, *lb)
+			Expect(err).NotTo(HaveOccurred())
 
-			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
-				NamespacedName: typeNamespacedName,
+			By("changing the allowed source ranges")
+			resource.Spec.ControlPlaneLoadBalancer.AllowedSourceRanges = []string{"192.0.2.0/24"}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			lb, err = api.LoadBalancers().Get(ctx, lb.Name)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lb.Options.AllowedSourceRanges).To(Equal([]string{"192.0.2.0/24"}))
+			Expect(lb.TargetPools[0].Targets).To(ConsistOf(target))
+		})
+
+		It("should reconcile the security group rules declaratively", func() {
+			resource := &infrastructurev1alpha1.StackitCluster{}
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+				g.Expect(resource.Status.Ready).To(BeTrue())
+			}).Should(Succeed())
+			controlPlaneID := resource.Status.SecurityGroups[infrastructurev1alpha1.SecurityGroupControlPlane].ID
+			workerID := resource.Status.SecurityGroups[infrastructurev1alpha1.SecurityGroupWorker].ID
+
+			api, err := cloudFactory(ctx, cloud.Scope{ProjectID: projectID, Region: "eu01"})
+			Expect(err).NotTo(HaveOccurred())
+			port := func(protocol string, port int32, remoteGroupID string) gomegatypes.GomegaMatcher {
+				return And(
+					HaveField("Protocol", protocol),
+					HaveField("PortRangeMin", port),
+					HaveField("RemoteSecurityGroupID", remoteGroupID),
+				)
+			}
+			controlPlane, err := api.SecurityGroups().Get(ctx, controlPlaneID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(controlPlane.Rules).To(ContainElements(
+				port("tcp", 6443, workerID),
+				port("tcp", 2379, controlPlaneID),
+				port("tcp", 10250, workerID),
+				port("tcp", 179, workerID),
+				port("udp", 4789, controlPlaneID),
+			))
+			Expect(controlPlane.Rules).NotTo(ContainElement(port("tcp", 2379, workerID)))
+			worker, err := api.SecurityGroups().Get(ctx, workerID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(worker.Rules).To(ContainElements(port("tcp", 10250, controlPlaneID), port("tcp", 5473, workerID)))
+			Expect(worker.Rules).NotTo(ContainElement(HaveField("PortRangeMin", BeEquivalentTo(6443))))
+
+			By("removing manually added rules and switching the CNI plugin")
+			manual, err := api.SecurityGroups().CreateRule(ctx, workerID, cloud.SecurityGroupRule{
+				Direction:      cloud.DirectionIngress,
+				Protocol:       "tcp",
+				PortRangeMin:   8080,
+				PortRangeMax:   8080,
+				RemoteIPPrefix: "0.0.0.0/0",
 			})
 			Expect(err).NotTo(HaveOccurred())
-			// TODO(user): Add more specific assertions depending on your controller's reconciliation logic.
-			// Example: If you expect a certain status condition after reconciliation, verify it here.
+			resource.Spec.SecurityGroups.CNI = infrastructurev1alpha1.CNICilium
+			resource.Spec.SecurityGroups.Worker = []infrastructurev1alpha1.SecurityGroupRule{
+				{Description: "NodePort services", Protocol: "tcp", PortRangeMin: 30000, PortRangeMax: 32767, RemoteIPPrefix: "10.0.0.0/24"},
+			}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			worker, err = api.SecurityGroups().Get(ctx, workerID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(worker.Rules).NotTo(ContainElement(HaveField("ID", manual.ID)))
+			Expect(worker.Rules).NotTo(ContainElement(port("tcp", 179, workerID)))
+			Expect(worker.Rules).To(ContainElements(
+				port("udp", 8472, workerID),
+				port("icmp", 0, controlPlaneID),
+				And(HaveField("PortRangeMin", BeEquivalentTo(30000)), HaveField("RemoteIPPrefix", "10.0.0.0/24")),
+			))
+
+			By("allowing all traffic between the machines")
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.SecurityGroups.AllowAllInClusterTraffic = true
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			controlPlane, err = api.SecurityGroups().Get(ctx, controlPlaneID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(controlPlane.Rules).To(ContainElements(port("", 0, controlPlaneID), port("", 0, workerID)))
+
+			By("reconciling again without changes")
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			unchanged, err := api.SecurityGroups().Get(ctx, controlPlaneID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(unchanged.Rules).To(Equal(controlPlane.Rules))
+		})
+
+		It("should manage the bastion host", func() {
+			api, err := cloudFactory(ctx, cloud.Scope{ProjectID: projectID, Region: "eu01"})
+			Expect(err).NotTo(HaveOccurred())
+			imageID := stackitAPI.AddImage(stackit.ImageModel{Name: "bastion-test"})
+
+			resource := &infrastructurev1alpha1.StackitCluster{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.Bastion = &infrastructurev1alpha1.BastionSpec{
+				MachineType:  "c1.1",
+				Image:        infrastructurev1alpha1.ImageSpec{ID: ptr.To(imageID)},
+				SSHKeyName:   "admin",
+				AllowedCIDRs: []string{"198.51.100.0/24"},
+			}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			By("reconciling the bastion host")
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+				g.Expect(conditions.IsTrue(resource, infrastructurev1alpha1.BastionReadyCondition)).To(BeTrue())
+			}).Should(Succeed())
+			Expect(resource.Status.Ready).To(BeTrue())
+			bastion := resource.Status.Bastion
+			Expect(bastion).NotTo(BeNil())
+			Expect(bastion.State).To(Equal(infrastructurev1alpha1.InstanceStateActive))
+			Expect(bastion.Address).NotTo(BeEmpty())
+			Expect(bastion.PrivateAddress).NotTo(BeEmpty())
+
+			server, err := api.Servers().Get(ctx, bastion.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.Name).To(Equal(resourceName(resource, "bastion")))
+			Expect(server.Labels).To(HaveKeyWithValue(cloud.RoleLabel, "bastion"))
+			Expect(server.NICs[0].PublicIP).To(Equal(bastion.Address))
+			Expect(stackitAPI.Servers()).To(ContainElement(And(
+				HaveField("ID", bastion.ID),
+				HaveField("SecurityGroups", ConsistOf(bastion.SecurityGroupID)),
+			)))
+
+			group, err := api.SecurityGroups().Get(ctx, bastion.SecurityGroupID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Rules).To(ConsistOf(And(
+				HaveField("RemoteIPPrefix", "198.51.100.0/24"),
+				HaveField("PortRangeMin", BeEquivalentTo(22)),
+			)))
+			fromBastion := HaveField("RemoteSecurityGroupID", bastion.SecurityGroupID)
+			for _, status := range resource.Status.SecurityGroups {
+				group, err := api.SecurityGroups().Get(ctx, status.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(group.Rules).To(ContainElement(fromBastion))
+			}
+
+			By("changing the allowed CIDRs")
+			resource.Spec.Bastion.AllowedCIDRs = []string{"203.0.113.0/24"}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			group, err = api.SecurityGroups().Get(ctx, bastion.SecurityGroupID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Rules).To(ConsistOf(HaveField("RemoteIPPrefix", "203.0.113.0/24")))
+
+			By("removing the bastion host")
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.Bastion = nil
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+				g.Expect(resource.Status.Bastion).To(BeNil())
+			}).Should(Succeed())
+			Expect(conditions.Get(resource, infrastructurev1alpha1.BastionReadyCondition)).To(BeNil())
+			_, err = api.Servers().Get(ctx, bastion.ID)
+			Expect(cloud.IsNotFound(err)).To(BeTrue())
+			_, err = api.SecurityGroups().Get(ctx, bastion.SecurityGroupID)
+			Expect(cloud.IsNotFound(err)).To(BeTrue())
+			ips, err := api.PublicIPs().List(ctx, map[string]string{cloud.ClusterUIDLabel: string(resource.UID)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ips).To(BeEmpty())
+			for _, status := range resource.Status.SecurityGroups {
+				group, err := api.SecurityGroups().Get(ctx, status.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(group.Rules).NotTo(ContainElement(fromBastion))
+			}
+
+			By("deleting the resource with a bastion host")
+			resource.Spec.Bastion = &infrastructurev1alpha1.BastionSpec{
+				MachineType:  "c1.1",
+				Image:        infrastructurev1alpha1.ImageSpec{ID: ptr.To(imageID)},
+				AllowedCIDRs: []string{"198.51.100.0/24"},
+			}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+				g.Expect(conditions.IsTrue(resource, infrastructurev1alpha1.BastionReadyCondition)).To(BeTrue())
+			}).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+			}).Should(Succeed())
+			Expect(stackitAPI.Servers()).NotTo(ContainElement(HaveField("Labels", HaveKeyWithValue(cloud.ClusterUIDLabel, string(resource.UID)))))
+			ips, err = api.PublicIPs().List(ctx, map[string]string{cloud.ClusterUIDLabel: string(resource.UID)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ips).To(BeEmpty())
+		})
+
+		It("should manage the control plane server group", func() {
+			api, err := cloudFactory(ctx, cloud.Scope{ProjectID: projectID, Region: "eu01"})
+			Expect(err).NotTo(HaveOccurred())
+
+			resource := &infrastructurev1alpha1.StackitCluster{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.ControlPlaneServerGroup.Policy = infrastructurev1alpha1.ServerGroupPolicySoftAntiAffinity
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			By("creating the server group before the cluster becomes ready")
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+				g.Expect(resource.Status.Ready).To(BeTrue())
+			}).Should(Succeed())
+			Expect(resource.Status.ControlPlaneServerGroupID).NotTo(BeEmpty())
+			group, err := api.ServerGroups().Get(ctx, resource.Status.ControlPlaneServerGroupID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Name).To(Equal(resourceName(resource, "control-plane")))
+			Expect(group.Policy).To(Equal(cloud.ServerGroupPolicySoftAntiAffinity))
+
+			By("reconciling again without creating duplicates")
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			groups, err := api.ServerGroups().List(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(groups).To(ConsistOf(HaveField("ID", group.ID)))
+
+			By("deleting the server group with the cluster")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+			}).Should(Succeed())
+			_, err = api.ServerGroups().Get(ctx, group.ID)
+			Expect(cloud.IsNotFound(err)).To(BeTrue())
 		})
 	})
 })
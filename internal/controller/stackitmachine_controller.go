@@ -18,46 +18,765 @@ package controller
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"slices"
 
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	clusterv1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/capi/v1beta1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/conditions"
 )
 
 // StackitMachineReconciler reconciles a StackitMachine object
 type StackitMachineReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// CloudFactory creates the STACKIT clients used to manage the infrastructure.
+	CloudFactory cloud.Factory
+
+	// ObjectStorageFactory creates the clients of the buckets Ignition
+	// configs are uploaded to.
+	ObjectStorageFactory cloud.ObjectStorageFactory
 }
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitmachines,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitmachines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitmachines/finalizers,verbs=update
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;machines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitclusteridentities,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets;namespaces,verbs=get;list;watch
+
+// Reconcile creates the STACKIT server backing a StackitMachine once the
+// cluster infrastructure and the bootstrap data are available, and deletes
+// the server and its volumes when the StackitMachine is deleted.
+func (r *StackitMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := logf.FromContext(ctx)
+
+	stackitMachine := &infrastructurev1alpha1.StackitMachine{}
+	if err := r.Get(ctx, req.NamespacedName, stackitMachine); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	machine, err := getOwnerMachine(ctx, r.Client, stackitMachine.ObjectMeta)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if machine == nil {
+		log.Info("Waiting for the Machine controller to set the owner reference")
+		return ctrl.Result{}, nil
+	}
+	log = log.WithValues("machine", machine.Name)
+
+	cluster, err := getCluster(ctx, r.Client, machine.Namespace, machine.Spec.ClusterName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	log = log.WithValues("cluster", cluster.Name)
+
+	if isPaused(cluster, stackitMachine) {
+		log.Info("Reconciliation is paused")
+		return ctrl.Result{}, nil
+	}
+
+	deleting := !stackitMachine.DeletionTimestamp.IsZero()
+	stackitCluster, err := getStackitCluster(ctx, r.Client, cluster)
+	if err != nil && (!deleting || !apierrors.IsNotFound(err)) {
+		return ctrl.Result{}, err
+	}
+	if stackitCluster == nil && !deleting {
+		log.Info("Waiting for the Cluster infrastructureRef to be set")
+		return ctrl.Result{}, nil
+	}
+	ctx = logf.IntoContext(ctx, log)
+
+	before := stackitMachine.DeepCopy()
+	defer func() {
+		summary := []infrastructurev1alpha1.ConditionType{
+			infrastructurev1alpha1.BootstrapDataAvailableCondition,
+			infrastructurev1alpha1.InstanceReadyCondition,
+		}
+		if isControlPlaneMachine(machine) {
+			summary = append(summary, infrastructurev1alpha1.APIServerTargetRegisteredCondition)
+		}
+		conditions.SetSummary(stackitMachine, summary...)
+		if err := patchObject(ctx, r.Client, before, stackitMachine); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	// The server cannot be deleted without the project and credentials of
+	// the StackitCluster.
+	if stackitCluster == nil {
+		return ctrl.Result{}, markDeletionBlocked(stackitMachine, infrastructurev1alpha1.InstanceReadyCondition,
+			infrastructurev1alpha1.MachineFinalizer, fmt.Errorf("the StackitCluster of cluster %s does not exist", cluster.Name))
+	}
+	stackit, err := newCloudClient(ctx, r.Client, r.CloudFactory, stackitCluster)
+	if err != nil && deleting {
+		return ctrl.Result{}, markDeletionBlocked(stackitMachine, infrastructurev1alpha1.InstanceReadyCondition,
+			infrastructurev1alpha1.MachineFinalizer, err)
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if deleting {
+		return r.reconcileDelete(ctx, stackit, cluster, stackitCluster, stackitMachine)
+	}
+	return r.reconcileNormal(ctx, stackit, cluster, machine, stackitCluster, stackitMachine)
+}
+
+func (r *StackitMachineReconciler) reconcileNormal(
+	ctx context.Context,
+	stackit cloud.Interface,
+	cluster *clusterv1.Cluster,
+	machine *clusterv1.Machine,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+	stackitMachine *infrastructurev1alpha1.StackitMachine,
+) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if stackitMachine.Status.FailureReason != nil {
+		log.Info("StackitMachine has failed, not reconciling", "reason", *stackitMachine.Status.FailureReason)
+		return ctrl.Result{}, nil
+	}
+
+	controllerutil.AddFinalizer(stackitMachine, infrastructurev1alpha1.MachineFinalizer)
+
+	if !cluster.Status.InfrastructureReady {
+		log.Info("Waiting for the cluster infrastructure to be ready")
+		conditions.MarkFalse(stackitMachine, infrastructurev1alpha1.InstanceReadyCondition,
+			infrastructurev1alpha1.WaitingForClusterInfrastructureReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+		return ctrl.Result{}, nil
+	}
 
-// Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the StackitMachine object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
-//
-// For more details, check Reconcile and its Result here:
-// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.21.0/pkg/reconcile
-func (r *StackitMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = logf.FromContext(ctx)
+	server, err := r.findServer(ctx, stackit, stackitMachine)
+	if err != nil {
+		conditions.MarkFalse(stackitMachine, infrastructurev1alpha1.InstanceReadyCondition,
+			infrastructurev1alpha1.InstanceReconciliationFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+		return ctrl.Result{}, err
+	}
+	if server == nil && stackitMachine.Spec.ProviderID != nil {
+		message := fmt.Sprintf("server %s no longer exists", *stackitMachine.Spec.ProviderID)
+		setMachineFailure(stackitMachine, clusterv1.UpdateMachineError, message)
+		conditions.MarkFalse(stackitMachine, infrastructurev1alpha1.InstanceReadyCondition,
+			infrastructurev1alpha1.InstanceNotFoundReason, infrastructurev1alpha1.ConditionSeverityError, "%s", message)
+		stackitMachine.Status.Ready = false
+		return ctrl.Result{}, nil
+	}
+	if server == nil {
+		if machine.Spec.Bootstrap.DataSecretName == nil {
+			log.Info("Waiting for the bootstrap data to be available")
+			conditions.MarkFalse(stackitMachine, infrastructurev1alpha1.BootstrapDataAvailableCondition,
+				infrastructurev1alpha1.WaitingForBootstrapDataReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+			conditions.MarkFalse(stackitMachine, infrastructurev1alpha1.InstanceReadyCondition,
+				infrastructurev1alpha1.WaitingForBootstrapDataReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+			return ctrl.Result{}, nil
+		}
+		bootstrapData, err := getBootstrapData(ctx, r.Client, machine.Namespace, machine.Spec.Bootstrap)
+		if err != nil {
+			conditions.MarkFalse(stackitMachine, infrastructurev1alpha1.BootstrapDataAvailableCondition,
+				infrastructurev1alpha1.BootstrapDataUnavailableReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+			return ctrl.Result{}, err
+		}
+		userData := bootstrapData.value
+		var storage cloud.ObjectStorage
+		if bootstrapData.format == ignitionFormat && stackitMachine.Spec.Ignition != nil {
+			storage, err = r.objectStorage(ctx, stackitCluster, stackitMachine)
+			if err == nil {
+				userData, err = ignitionStub(storage, stackitMachine, bootstrapData.value)
+			}
+			if err != nil {
+				conditions.MarkFalse(stackitMachine, infrastructurev1alpha1.BootstrapDataAvailableCondition,
+					infrastructurev1alpha1.BootstrapDataUploadFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+				return ctrl.Result{}, err
+			}
+		}
+		// Checked before creating anything in the cloud. Retrying does not
+		// help until the bootstrap data Secret changes, which is watched.
+		if size := base64.StdEncoding.EncodedLen(len(userData)); size > cloud.MaxUserDataSize {
+			conditions.MarkFalse(stackitMachine, infrastructurev1alpha1.BootstrapDataAvailableCondition,
+				infrastructurev1alpha1.BootstrapDataTooLargeReason, infrastructurev1alpha1.ConditionSeverityError,
+				"%s bootstrap data is %d bytes base64 encoded, more than the %d bytes allowed as user data",
+				bootstrapData.format, size, cloud.MaxUserDataSize)
+			return ctrl.Result{}, nil
+		}
+		// The volumes are attached when the server is created, so that they
+		// are available to the bootstrap data.
+		volumeIDs, ready, err := r.reconcileAdditionalVolumes(ctx, stackit, machine, stackitCluster, stackitMachine)
+		if err != nil {
+			conditions.MarkFalse(stackitMachine, infrastructurev1alpha1.InstanceReadyCondition,
+				infrastructurev1alpha1.VolumeProvisionFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+			return ctrl.Result{}, err
+		}
+		if !ready {
+			return ctrl.Result{RequeueAfter: requeueAfter}, nil
+		}
+		if storage != nil {
+			if err := uploadIgnition(ctx, storage, stackitMachine, bootstrapData.value); err != nil {
+				conditions.MarkFalse(stackitMachine, infrastructurev1alpha1.BootstrapDataAvailableCondition,
+					infrastructurev1alpha1.BootstrapDataUploadFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+				return ctrl.Result{}, err
+			}
+		}
+		conditions.MarkTrue(stackitMachine, infrastructurev1alpha1.BootstrapDataAvailableCondition)
+		log.Info("Read bootstrap data", "format", bootstrapData.format, "size", len(userData))
 
-	// TODO(user): your logic here
+		server, err = r.createServer(ctx, stackit, machine, stackitCluster, stackitMachine, volumeIDs, userData)
+		if err != nil {
+			conditions.MarkFalse(stackitMachine, infrastructurev1alpha1.InstanceReadyCondition,
+				infrastructurev1alpha1.InstanceProvisionFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+			return ctrl.Result{}, err
+		}
+		log.Info("Created server", "serverID", server.ID)
+	}
+	// The bootstrap data has been handed to the server.
+	conditions.MarkTrue(stackitMachine, infrastructurev1alpha1.BootstrapDataAvailableCondition)
 
+	stackitMachine.Spec.ProviderID = ptr.To(cloud.ProviderID(server.ID))
+	stackitMachine.Status.InstanceState = ptr.To(infrastructurev1alpha1.InstanceState(server.Status))
+	stackitMachine.Status.ImageID = server.ImageID
+	stackitMachine.Status.ServerGroupID = server.ServerGroupID
+
+	switch server.Status {
+	case cloud.ServerStatusActive:
+		if stackitMachine.Spec.PublicIP != nil {
+			if err := r.reconcilePublicIP(ctx, stackit, stackitCluster, stackitMachine, server); err != nil {
+				conditions.MarkFalse(stackitMachine, infrastructurev1alpha1.InstanceReadyCondition,
+					infrastructurev1alpha1.PublicIPAssociationFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+				return ctrl.Result{}, err
+			}
+		}
+		stackitMachine.Status.Addresses = machineAddresses(server)
+		if isControlPlaneMachine(machine) {
+			if err := r.registerAPIServerTarget(ctx, stackit, stackitCluster, stackitMachine, server); err != nil {
+				conditions.MarkFalse(stackitMachine, infrastructurev1alpha1.APIServerTargetRegisteredCondition,
+					infrastructurev1alpha1.APIServerTargetRegistrationFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+				return ctrl.Result{}, err
+			}
+			conditions.MarkTrue(stackitMachine, infrastructurev1alpha1.APIServerTargetRegisteredCondition)
+		}
+		stackitMachine.Status.Ready = true
+		conditions.MarkTrue(stackitMachine, infrastructurev1alpha1.InstanceReadyCondition)
+		// Ignition has fetched the uploaded config once the node exists.
+		if stackitMachine.Status.IgnitionObjectKey != "" && machine.Status.NodeRef != nil {
+			if err := r.deleteIgnitionObject(ctx, stackitCluster, stackitMachine); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	case cloud.ServerStatusError:
+		message := fmt.Sprintf("server %s is in state ERROR: %s", server.ID, server.ErrorMessage)
+		setMachineFailure(stackitMachine, clusterv1.CreateMachineError, message)
+		conditions.MarkFalse(stackitMachine, infrastructurev1alpha1.InstanceReadyCondition,
+			infrastructurev1alpha1.InstanceProvisionFailedReason, infrastructurev1alpha1.ConditionSeverityError, "%s", message)
+		stackitMachine.Status.Ready = false
+		return ctrl.Result{}, nil
+	default:
+		log.Info("Waiting for the server to become active", "serverID", server.ID, "state", server.Status)
+		conditions.MarkFalse(stackitMachine, infrastructurev1alpha1.InstanceReadyCondition,
+			infrastructurev1alpha1.InstanceProvisioningReason, infrastructurev1alpha1.ConditionSeverityInfo,
+			"Server %s is in state %s", server.ID, server.Status)
+		stackitMachine.Status.Ready = false
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+}
+
+func (r *StackitMachineReconciler) reconcileDelete(
+	ctx context.Context,
+	stackit cloud.Interface,
+	cluster *clusterv1.Cluster,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+	stackitMachine *infrastructurev1alpha1.StackitMachine,
+) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	conditions.MarkFalse(stackitMachine, infrastructurev1alpha1.InstanceReadyCondition,
+		infrastructurev1alpha1.DeletingReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+
+	server, err := r.findServer(ctx, stackit, stackitMachine)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if server != nil {
+		stackitMachine.Status.Ready = false
+		stackitMachine.Status.InstanceState = ptr.To(infrastructurev1alpha1.InstanceState(server.Status))
+		if server.Status != cloud.ServerStatusDeleting {
+			// Stop sending API requests to the machine before it goes away.
+			if server.Labels[cloud.RoleLabel] == string(infrastructurev1alpha1.SecurityGroupControlPlane) {
+				if err := r.deregisterAPIServerTarget(ctx, stackit, stackitCluster, stackitMachine); err != nil {
+					return ctrl.Result{}, err
+				}
+				conditions.MarkFalse(stackitMachine, infrastructurev1alpha1.APIServerTargetRegisteredCondition,
+					infrastructurev1alpha1.DeletingReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+			}
+			log.Info("Deleting server", "serverID", server.ID)
+			if err := stackit.Servers().Delete(ctx, server.ID); err != nil && !cloud.IsNotFound(err) {
+				return ctrl.Result{}, fmt.Errorf("deleting server %s: %w", server.ID, err)
+			}
+		}
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	// Pre-allocated public IPs are not labeled and therefore kept.
+	ips, err := stackit.PublicIPs().List(ctx, map[string]string{cloud.MachineUIDLabel: string(stackitMachine.UID)})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("listing public IPs: %w", err)
+	}
+	for _, ip := range ips {
+		log.Info("Releasing public IP", "publicIPID", ip.ID, "address", ip.IP)
+		if err := stackit.PublicIPs().Delete(ctx, ip.ID); err != nil && !cloud.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("releasing public IP %s: %w", ip.ID, err)
+		}
+	}
+	stackitMachine.Status.PublicIP = nil
+
+	volumes, err := stackit.Volumes().List(ctx, map[string]string{cloud.MachineUIDLabel: string(stackitMachine.UID)})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("listing volumes: %w", err)
+	}
+	kept := keptVolumeNames(stackitMachine)
+	for _, volume := range volumes {
+		if kept.Has(volume.Name) {
+			// Kept volumes no longer belong to the machine.
+			labels := maps.Clone(volume.Labels)
+			delete(labels, cloud.MachineUIDLabel)
+			if _, err := stackit.Volumes().UpdateLabels(ctx, volume.ID, labels); err != nil && !cloud.IsNotFound(err) {
+				return ctrl.Result{}, fmt.Errorf("removing the machine label of volume %s: %w", volume.ID, err)
+			}
+			log.Info("Keeping volume", "volumeID", volume.ID)
+			continue
+		}
+		log.Info("Deleting volume", "volumeID", volume.ID)
+		if err := stackit.Volumes().Delete(ctx, volume.ID); err != nil && !cloud.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("deleting volume %s: %w", volume.ID, err)
+		}
+	}
+
+	if stackitMachine.Status.IgnitionObjectKey != "" {
+		if err := r.deleteIgnitionObject(ctx, stackitCluster, stackitMachine); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if err := r.deleteMachineServerGroup(ctx, stackit, cluster, stackitCluster, stackitMachine); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	controllerutil.RemoveFinalizer(stackitMachine, infrastructurev1alpha1.MachineFinalizer)
 	return ctrl.Result{}, nil
 }
 
+// findServer returns the server of stackitMachine, looked up by its provider
+// ID or, if the provider ID has not been recorded yet, by the machine UID
+// label. It returns nil if the server does not exist.
+func (r *StackitMachineReconciler) findServer(
+	ctx context.Context,
+	stackit cloud.Interface,
+	stackitMachine *infrastructurev1alpha1.StackitMachine,
+) (*cloud.Server, error) {
+	if stackitMachine.Spec.ProviderID != nil {
+		id, err := cloud.ServerIDFromProviderID(*stackitMachine.Spec.ProviderID)
+		if err != nil {
+			return nil, err
+		}
+		server, err := stackit.Servers().Get(ctx, id)
+		if cloud.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("getting server %s: %w", id, err)
+		}
+		return server, nil
+	}
+
+	servers, err := stackit.Servers().List(ctx, map[string]string{cloud.MachineUIDLabel: string(stackitMachine.UID)})
+	if err != nil {
+		return nil, fmt.Errorf("listing servers: %w", err)
+	}
+	switch len(servers) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &servers[0], nil
+	default:
+		return nil, fmt.Errorf("found %d servers labeled %s=%s", len(servers), cloud.MachineUIDLabel, stackitMachine.UID)
+	}
+}
+
+func (r *StackitMachineReconciler) createServer(
+	ctx context.Context,
+	stackit cloud.Interface,
+	machine *clusterv1.Machine,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+	stackitMachine *infrastructurev1alpha1.StackitMachine,
+	volumeIDs []string,
+	userData []byte,
+) (*cloud.Server, error) {
+	imageID, err := newImageResolver(stackit).resolve(ctx, stackitMachine.Spec.Image, ptr.Deref(machine.Spec.Version, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	if stackitCluster.Status.Network == nil {
+		return nil, fmt.Errorf("the network of StackitCluster %s is not known yet", stackitCluster.Name)
+	}
+
+	role := infrastructurev1alpha1.SecurityGroupWorker
+	if isControlPlaneMachine(machine) {
+		role = infrastructurev1alpha1.SecurityGroupControlPlane
+	}
+	securityGroupIDs := slices.Clone(stackitMachine.Spec.SecurityGroups)
+	if group, ok := stackitCluster.Status.SecurityGroups[role]; ok {
+		securityGroupIDs = append(securityGroupIDs, group.ID)
+	}
+
+	availabilityZone, err := machineAvailabilityZone(machine, stackitCluster, stackitMachine)
+	if err != nil {
+		return nil, err
+	}
+
+	serverGroupID, err := machineServerGroup(ctx, stackit, machine, stackitCluster, stackitMachine)
+	if err != nil {
+		return nil, err
+	}
+
+	req := cloud.CreateServerRequest{
+		Name:             stackitMachine.Name,
+		MachineType:      stackitMachine.Spec.MachineType,
+		ImageID:          imageID,
+		AvailabilityZone: availabilityZone,
+		KeypairName:      stackitMachine.Spec.SSHKeyName,
+		NetworkID:        stackitCluster.Status.Network.ID,
+		SecurityGroupIDs: securityGroupIDs,
+		VolumeIDs:        volumeIDs,
+		ServerGroupID:    serverGroupID,
+		UserData:         userData,
+		Labels: map[string]string{
+			cloud.ClusterUIDLabel: string(stackitCluster.UID),
+			cloud.MachineUIDLabel: string(stackitMachine.UID),
+			cloud.RoleLabel:       string(role),
+		},
+	}
+	if bootVolume := stackitMachine.Spec.BootVolume; bootVolume.Size > 0 {
+		req.BootVolume = &cloud.BootVolume{
+			Size:                bootVolume.Size,
+			PerformanceClass:    bootVolume.PerformanceClass,
+			DeleteOnTermination: true,
+		}
+	}
+
+	server, err := stackit.Servers().Create(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("creating server: %w", err)
+	}
+	return server, nil
+}
+
+// machineAvailabilityZone returns the availability zone of the server of
+// stackitMachine. The failure domain chosen by Cluster API, for example to
+// spread the control plane, takes precedence over the zone of the template.
+// Without either, STACKIT chooses the zone of the server, unless the
+// machine has additional volumes: these must be created in the zone of the
+// server before it exists, so the first failure domain of the cluster is
+// used for both.
+func machineAvailabilityZone(
+	machine *clusterv1.Machine,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+	stackitMachine *infrastructurev1alpha1.StackitMachine,
+) (string, error) {
+	if machine.Spec.FailureDomain == nil || *machine.Spec.FailureDomain == "" {
+		if stackitMachine.Spec.AvailabilityZone != "" || len(stackitMachine.Spec.AdditionalVolumes) == 0 {
+			return stackitMachine.Spec.AvailabilityZone, nil
+		}
+		if len(stackitCluster.Status.FailureDomains) == 0 {
+			return "", fmt.Errorf("the failure domains of StackitCluster %s are not known yet", stackitCluster.Name)
+		}
+		return slices.Sorted(maps.Keys(stackitCluster.Status.FailureDomains))[0], nil
+	}
+	availabilityZone := *machine.Spec.FailureDomain
+	if _, ok := stackitCluster.Status.FailureDomains[availabilityZone]; !ok {
+		return "", fmt.Errorf("failure domain %q is not a failure domain of StackitCluster %s", availabilityZone, stackitCluster.Name)
+	}
+	return availabilityZone, nil
+}
+
+// reconcilePublicIP looks up the public IP referenced by the spec, or
+// allocates one for the machine, and associates it with server.
+func (r *StackitMachineReconciler) reconcilePublicIP(
+	ctx context.Context,
+	stackit cloud.Interface,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+	stackitMachine *infrastructurev1alpha1.StackitMachine,
+	server *cloud.Server,
+) error {
+	log := logf.FromContext(ctx)
+
+	var ip *cloud.PublicIP
+	unmanaged := stackitMachine.Spec.PublicIP.ID != ""
+	if unmanaged {
+		var err error
+		ip, err = stackit.PublicIPs().Get(ctx, stackitMachine.Spec.PublicIP.ID)
+		if err != nil {
+			return fmt.Errorf("getting public IP %s: %w", stackitMachine.Spec.PublicIP.ID, err)
+		}
+	} else {
+		labels := map[string]string{cloud.MachineUIDLabel: string(stackitMachine.UID)}
+		ips, err := stackit.PublicIPs().List(ctx, labels)
+		if err != nil {
+			return fmt.Errorf("listing public IPs: %w", err)
+		}
+		switch len(ips) {
+		case 0:
+			labels[cloud.ClusterUIDLabel] = string(stackitCluster.UID)
+			ip, err = stackit.PublicIPs().Create(ctx, cloud.CreatePublicIPRequest{Labels: labels})
+			if err != nil {
+				return fmt.Errorf("allocating public IP: %w", err)
+			}
+			log.Info("Allocated public IP", "publicIPID", ip.ID, "address", ip.IP)
+		case 1:
+			ip = &ips[0]
+		default:
+			return fmt.Errorf("found %d public IPs labeled %s=%s", len(ips), cloud.MachineUIDLabel, stackitMachine.UID)
+		}
+	}
+	stackitMachine.Status.PublicIP = &infrastructurev1alpha1.PublicIPStatus{
+		ID:        ip.ID,
+		Address:   ip.IP,
+		Unmanaged: unmanaged,
+	}
+
+	return associatePublicIP(ctx, stackit, server, ip)
+}
+
+// registerAPIServerTarget adds the server of a control plane machine to the
+// target pool of the API server load balancer, replacing a stale target of
+// the machine.
+func (r *StackitMachineReconciler) registerAPIServerTarget(
+	ctx context.Context,
+	stackit cloud.Interface,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+	stackitMachine *infrastructurev1alpha1.StackitMachine,
+	server *cloud.Server,
+) error {
+	if stackitCluster.Status.APIServerLoadBalancer == nil {
+		return fmt.Errorf("the API server load balancer of StackitCluster %s is not known yet", stackitCluster.Name)
+	}
+	if stackitCluster.Status.Network == nil {
+		return fmt.Errorf("the network of StackitCluster %s is not known yet", stackitCluster.Name)
+	}
+	var ip string
+	for _, nic := range server.NICs {
+		if nic.NetworkID == stackitCluster.Status.Network.ID && nic.IPv4 != "" {
+			ip = nic.IPv4
+			break
+		}
+	}
+	if ip == "" {
+		return fmt.Errorf("server %s has no IP address in the cluster network", server.ID)
+	}
+
+	target := cloud.Target{DisplayName: stackitMachine.Name, IP: ip}
+	return updateAPIServerTargets(ctx, stackit, stackitCluster.Status.APIServerLoadBalancer.Name, apiServerPort(stackitCluster),
+		func(targets []cloud.Target) []cloud.Target {
+			targets = slices.DeleteFunc(targets, func(t cloud.Target) bool {
+				return t.DisplayName == target.DisplayName && t != target
+			})
+			if !slices.Contains(targets, target) {
+				targets = append(targets, target)
+			}
+			return targets
+		})
+}
+
+// deregisterAPIServerTarget removes the server of a control plane machine
+// from the target pool of the API server load balancer.
+func (r *StackitMachineReconciler) deregisterAPIServerTarget(
+	ctx context.Context,
+	stackit cloud.Interface,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+	stackitMachine *infrastructurev1alpha1.StackitMachine,
+) error {
+	name := apiServerLoadBalancerName(stackitCluster)
+	if lb := stackitCluster.Status.APIServerLoadBalancer; lb != nil {
+		name = lb.Name
+	}
+	err := updateAPIServerTargets(ctx, stackit, name, apiServerPort(stackitCluster), func(targets []cloud.Target) []cloud.Target {
+		return slices.DeleteFunc(targets, func(t cloud.Target) bool {
+			return t.DisplayName == stackitMachine.Name
+		})
+	})
+	if cloud.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// updateAPIServerTargets replaces the targets of the API server target pool
+// of the named load balancer with the result of update, if they changed. The
+// API server target pool is the one the listener on port forwards to. Load
+// balancers being deleted are left alone.
+func updateAPIServerTargets(
+	ctx context.Context,
+	stackit cloud.Interface,
+	name string,
+	port int32,
+	update func([]cloud.Target) []cloud.Target,
+) error {
+	lb, err := stackit.LoadBalancers().Get(ctx, name)
+	if err != nil {
+		return fmt.Errorf("getting load balancer %s: %w", name, err)
+	}
+	if lb.Status == cloud.LoadBalancerStatusTerminating {
+		return nil
+	}
+	poolName := apiServerTargetPool
+	for _, listener := range lb.Listeners {
+		if listener.Port == port {
+			poolName = listener.TargetPool
+			break
+		}
+	}
+	i := slices.IndexFunc(lb.TargetPools, func(pool cloud.TargetPool) bool {
+		return pool.Name == poolName
+	})
+	if i < 0 {
+		return fmt.Errorf("load balancer %s has no target pool %s", name, poolName)
+	}
+	targets := update(slices.Clone(lb.TargetPools[i].Targets))
+	if slices.Equal(targets, lb.TargetPools[i].Targets) {
+		return nil
+	}
+	lb.TargetPools[i].Targets = targets
+	if _, err := stackit.LoadBalancers().Update(ctx, *lb); err != nil {
+		return fmt.Errorf("updating targets of load balancer %s: %w", name, err)
+	}
+	logf.FromContext(ctx).Info("Updated API server load balancer targets", "loadBalancer", name, "targets", len(targets))
+	return nil
+}
+
+// machineAddresses returns the addresses of server.
+func machineAddresses(server *cloud.Server) []infrastructurev1alpha1.MachineAddress {
+	addresses := []infrastructurev1alpha1.MachineAddress{{
+		Type:    infrastructurev1alpha1.MachineHostName,
+		Address: server.Name,
+	}}
+	for _, nic := range server.NICs {
+		if nic.IPv4 != "" {
+			addresses = append(addresses, infrastructurev1alpha1.MachineAddress{
+				Type:    infrastructurev1alpha1.MachineInternalIP,
+				Address: nic.IPv4,
+			})
+		}
+		if nic.PublicIP != "" {
+			addresses = append(addresses, infrastructurev1alpha1.MachineAddress{
+				Type:    infrastructurev1alpha1.MachineExternalIP,
+				Address: nic.PublicIP,
+			})
+		}
+	}
+	return addresses
+}
+
+// setMachineFailure records a terminal failure of stackitMachine.
+func setMachineFailure(stackitMachine *infrastructurev1alpha1.StackitMachine, reason clusterv1.MachineStatusError, message string) {
+	stackitMachine.Status.FailureReason = ptr.To(string(reason))
+	stackitMachine.Status.FailureMessage = ptr.To(message)
+}
+
+// machineToStackitMachine maps a Machine to the StackitMachine referenced by
+// its infrastructureRef.
+func machineToStackitMachine(_ context.Context, obj client.Object) []reconcile.Request {
+	machine, ok := obj.(*clusterv1.Machine)
+	if !ok || !isInfrastructureRef(&machine.Spec.InfrastructureRef, "StackitMachine") {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Namespace: machine.Namespace,
+		Name:      machine.Spec.InfrastructureRef.Name,
+	}}}
+}
+
+// clusterToStackitMachines maps a Cluster to the StackitMachines of its
+// Machines.
+func (r *StackitMachineReconciler) clusterToStackitMachines(ctx context.Context, obj client.Object) []reconcile.Request {
+	return r.stackitMachinesOfCluster(ctx, obj.GetNamespace(), obj.GetName())
+}
+
+// stackitClusterToStackitMachines maps a StackitCluster to the
+// StackitMachines of the Machines of its owning Cluster.
+func (r *StackitMachineReconciler) stackitClusterToStackitMachines(ctx context.Context, obj client.Object) []reconcile.Request {
+	for _, ref := range obj.GetOwnerReferences() {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err == nil && gv.Group == clusterv1.GroupVersion.Group && ref.Kind == "Cluster" {
+			return r.stackitMachinesOfCluster(ctx, obj.GetNamespace(), ref.Name)
+		}
+	}
+	return nil
+}
+
+// stackitMachinesOfCluster returns requests for the StackitMachines of the
+// Machines belonging to the named Cluster.
+func (r *StackitMachineReconciler) stackitMachinesOfCluster(ctx context.Context, namespace, clusterName string) []reconcile.Request {
+	machines := &clusterv1.MachineList{}
+	if err := r.List(ctx, machines, client.InNamespace(namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: clusterName}); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list Machines", "cluster", clusterName)
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range machines.Items {
+		requests = append(requests, machineToStackitMachine(ctx, &machines.Items[i])...)
+	}
+	return requests
+}
+
+// secretToStackitMachines maps a bootstrap data Secret to the
+// StackitMachines of the Machines using it.
+func (r *StackitMachineReconciler) secretToStackitMachines(ctx context.Context, obj client.Object) []reconcile.Request {
+	clusterName, ok := obj.GetLabels()[clusterv1.ClusterNameLabel]
+	if !ok {
+		return nil
+	}
+	machines := &clusterv1.MachineList{}
+	if err := r.List(ctx, machines, client.InNamespace(obj.GetNamespace()),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: clusterName}); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list Machines", "cluster", clusterName)
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range machines.Items {
+		if ptr.Deref(machines.Items[i].Spec.Bootstrap.DataSecretName, "") == obj.GetName() {
+			requests = append(requests, machineToStackitMachine(ctx, &machines.Items[i])...)
+		}
+	}
+	return requests
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *StackitMachineReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrastructurev1alpha1.StackitMachine{}).
+		Watches(&clusterv1.Machine{}, handler.EnqueueRequestsFromMapFunc(machineToStackitMachine)).
+		Watches(&clusterv1.Cluster{}, handler.EnqueueRequestsFromMapFunc(r.clusterToStackitMachines)).
+		Watches(&infrastructurev1alpha1.StackitCluster{}, handler.EnqueueRequestsFromMapFunc(r.stackitClusterToStackitMachines)).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.secretToStackitMachines)).
 		Named("stackitmachine").
 		Complete(r)
 }
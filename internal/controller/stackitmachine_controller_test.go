@@ -18,67 +18,937 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-
 	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	clusterv1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/capi/v1beta1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud/stackit"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/conditions"
 )
 
 var _ = Describe("StackitMachine Controller", func() {
 	Context("When reconciling a resource", func() {
-		const resourceName = "test-resource"
+		const (
+			namespace   = "default"
+			clusterName = "machine-test"
+			machineName = "machine-test-md-0"
+			projectID   = "11111111-1111-1111-1111-111111111111"
+		)
 
 		ctx := context.Background()
 
 		typeNamespacedName := types.NamespacedName{
-			Name:      resourceName,
-			Namespace: "default", // TODO(user):Modify as needed
+			Name:      machineName,
+			Namespace: namespace,
 		}
-		stackitmachine := &infrastructurev1alpha1.StackitMachine{}
+
+		var (
+			reconciler *StackitMachineReconciler
+			machine    *clusterv1.Machine
+			networkID  string
+		)
 
 		BeforeEach(func() {
-			By("creating the custom resource for the Kind StackitMachine")
-			err := k8sClient.Get(ctx, typeNamespacedName, stackitmachine)
-			if err != nil && errors.IsNotFound(err) {
-				resource := &infrastructurev1alpha1.StackitMachine{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      resourceName,
-						Namespace: "default",
+			reconciler = &StackitMachineReconciler{
+				Client:               k8sClient,
+				Scheme:               k8sClient.Scheme(),
+				CloudFactory:         cloudFactory,
+				ObjectStorageFactory: objectStorageFactory,
+			}
+
+			By("creating the network and image in the STACKIT API")
+			api, err := cloudFactory(ctx, cloud.Scope{ProjectID: projectID, Region: "eu01"})
+			Expect(err).NotTo(HaveOccurred())
+			images, err := api.Images().List(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			if !slices.ContainsFunc(images, func(image cloud.Image) bool { return image.Name == "ubuntu-22.04" }) {
+				stackitAPI.AddImage(stackit.ImageModel{Name: "ubuntu-22.04"})
+			}
+			network, err := api.Networks().Create(ctx, cloud.CreateNetworkRequest{Name: clusterName, IPv4Prefix: "10.0.0.0/24"})
+			Expect(err).NotTo(HaveOccurred())
+			networkID = network.ID
+
+			By("creating the cluster")
+			stackitCluster := &infrastructurev1alpha1.StackitCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace},
+				Spec: infrastructurev1alpha1.StackitClusterSpec{
+					ProjectID: projectID,
+					Region:    "eu01",
+					Network:   infrastructurev1alpha1.NetworkSpec{ID: network.ID},
+				},
+			}
+			Expect(k8sClient.Create(ctx, stackitCluster)).To(Succeed())
+			stackitCluster.Status.Network = &infrastructurev1alpha1.NetworkStatus{ID: network.ID}
+			stackitCluster.Status.FailureDomains = infrastructurev1alpha1.FailureDomains{
+				"eu01-1": {ControlPlane: true},
+				"eu01-2": {ControlPlane: true},
+			}
+			Expect(k8sClient.Status().Update(ctx, stackitCluster)).To(Succeed())
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace},
+				Spec: clusterv1.ClusterSpec{
+					InfrastructureRef: &corev1.ObjectReference{
+						APIVersion: infrastructurev1alpha1.GroupVersion.String(),
+						Kind:       "StackitCluster",
+						Name:       clusterName,
 					},
-					// TODO(user): Specify other spec details if needed.
-				}
-				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+				},
 			}
+			Expect(k8sClient.Create(ctx, cluster)).To(Succeed())
+			cluster.Status.InfrastructureReady = true
+			Expect(k8sClient.Status().Update(ctx, cluster)).To(Succeed())
+
+			By("creating the machine")
+			machine = &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      machineName,
+					Namespace: namespace,
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: clusterName},
+				},
+				Spec: clusterv1.MachineSpec{
+					ClusterName: clusterName,
+					InfrastructureRef: corev1.ObjectReference{
+						APIVersion: infrastructurev1alpha1.GroupVersion.String(),
+						Kind:       "StackitMachine",
+						Name:       machineName,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, machine)).To(Succeed())
+
+			resource := &infrastructurev1alpha1.StackitMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      machineName,
+					Namespace: namespace,
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Machine",
+						Name:       machine.Name,
+						UID:        machine.UID,
+					}},
+				},
+				Spec: infrastructurev1alpha1.StackitMachineSpec{
+					MachineType: "c1.2",
+					Image: infrastructurev1alpha1.ImageSpec{
+						Name: ptr.To("ubuntu-22.04"),
+					},
+					BootVolume: infrastructurev1alpha1.BootVolumeSpec{Size: 20},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
 		})
 
 		AfterEach(func() {
-			// TODO(user): Cleanup logic after each test, like removing the resource instance.
 			resource := &infrastructurev1alpha1.StackitMachine{}
 			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			if err == nil {
+				controllerutil.RemoveFinalizer(resource, infrastructurev1alpha1.MachineFinalizer)
+				Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, resource))).To(Succeed())
+			} else {
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+			}
+
+			for _, obj := range []client.Object{
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: machineName, Namespace: namespace}},
+				&clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: machineName, Namespace: namespace}},
+				&clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace}},
+				&infrastructurev1alpha1.StackitCluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace}},
+			} {
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, obj))).To(Succeed())
+			}
+		})
+
+		It("should map Machines, Clusters, StackitClusters and bootstrap data Secrets to the StackitMachine", func() {
+			request := reconcile.Request{NamespacedName: typeNamespacedName}
+			Expect(machineToStackitMachine(ctx, machine)).To(ConsistOf(request))
+
+			cluster := &clusterv1.Cluster{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: clusterName, Namespace: namespace}, cluster)).To(Succeed())
+			Expect(reconciler.clusterToStackitMachines(ctx, cluster)).To(ConsistOf(request))
+
+			stackitCluster := &infrastructurev1alpha1.StackitCluster{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: clusterName, Namespace: namespace}, stackitCluster)).To(Succeed())
+			Expect(reconciler.stackitClusterToStackitMachines(ctx, stackitCluster)).To(BeEmpty())
+			stackitCluster.OwnerReferences = []metav1.OwnerReference{{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "Cluster",
+				Name:       cluster.Name,
+				UID:        cluster.UID,
+			}}
+			Expect(reconciler.stackitClusterToStackitMachines(ctx, stackitCluster)).To(ConsistOf(request))
+
+			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+				Name:      machineName,
+				Namespace: namespace,
+				Labels:    map[string]string{clusterv1.ClusterNameLabel: clusterName},
+			}}
+			Expect(reconciler.secretToStackitMachines(ctx, secret)).To(BeEmpty())
+			machine.Spec.Bootstrap.DataSecretName = ptr.To(secret.Name)
+			Expect(k8sClient.Update(ctx, machine)).To(Succeed())
+			Expect(reconciler.secretToStackitMachines(ctx, secret)).To(ConsistOf(request))
+			secret.Labels = nil
+			Expect(reconciler.secretToStackitMachines(ctx, secret)).To(BeEmpty())
+		})
+
+		It("should not reconcile when the cluster is paused", func() {
+			cluster := &clusterv1.Cluster{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: clusterName, Namespace: namespace}, cluster)).To(Succeed())
+			cluster.Spec.Paused = true
+			Expect(k8sClient.Update(ctx, cluster)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			resource := &infrastructurev1alpha1.StackitMachine{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Finalizers).To(BeEmpty())
+		})
+
+		It("should wait for the bootstrap data", func() {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			resource := &infrastructurev1alpha1.StackitMachine{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Finalizers).To(ContainElement(infrastructurev1alpha1.MachineFinalizer))
+			Expect(resource.Spec.ProviderID).To(BeNil())
+			Expect(resource.Status.Ready).To(BeFalse())
+			condition := conditions.Get(resource, infrastructurev1alpha1.BootstrapDataAvailableCondition)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(infrastructurev1alpha1.WaitingForBootstrapDataReason))
+			Expect(conditions.IsTrue(resource, infrastructurev1alpha1.ReadyCondition)).To(BeFalse())
+		})
+
+		It("should create and delete the server", func() {
+			By("providing the bootstrap data")
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: machineName, Namespace: namespace},
+				Data:       map[string][]byte{"value": []byte("#cloud-config\n")},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			machine.Spec.Bootstrap.DataSecretName = ptr.To(secret.Name)
+			machine.Spec.FailureDomain = ptr.To("eu01-2")
+			Expect(k8sClient.Update(ctx, machine)).To(Succeed())
+
+			By("reconciling the created resource")
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			resource := &infrastructurev1alpha1.StackitMachine{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Spec.ProviderID).NotTo(BeNil())
+			Expect(resource.Status.Ready).To(BeTrue())
+			Expect(resource.Status.InstanceState).To(HaveValue(Equal(infrastructurev1alpha1.InstanceStateActive)))
+			Expect(conditions.IsTrue(resource, infrastructurev1alpha1.InstanceReadyCondition)).To(BeTrue())
+			Expect(conditions.IsTrue(resource, infrastructurev1alpha1.ReadyCondition)).To(BeTrue())
+			Expect(resource.Status.Addresses).To(ContainElement(HaveField("Type", infrastructurev1alpha1.MachineInternalIP)))
+
+			serverID, err := cloud.ServerIDFromProviderID(*resource.Spec.ProviderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stackitAPI.Servers()).To(ContainElement(And(
+				HaveField("ID", serverID),
+				HaveField("AvailabilityZone", "eu01-2"),
+				HaveField("Labels", HaveKeyWithValue(cloud.MachineUIDLabel, string(resource.UID))),
+			)))
+
+			By("reconciling again without creating a second server")
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stackitAPI.Servers()).To(HaveLen(1))
+
+			By("deleting the resource")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+			}).Should(Succeed())
+			Expect(stackitAPI.Servers()).To(BeEmpty())
+		})
+
+		It("should report a deletion blocked by a missing StackitCluster or credentials", func() {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			resource := &infrastructurev1alpha1.StackitMachine{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+
+			expectDeletionBlocked := func(message string) {
+				Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+				Expect(resource.Finalizers).To(ContainElement(infrastructurev1alpha1.MachineFinalizer))
+				for _, t := range []infrastructurev1alpha1.ConditionType{
+					infrastructurev1alpha1.ReadyCondition,
+					infrastructurev1alpha1.InstanceReadyCondition,
+				} {
+					condition := conditions.Get(resource, t)
+					Expect(condition).NotTo(BeNil())
+					Expect(condition.Reason).To(Equal(infrastructurev1alpha1.DeletionBlockedReason))
+					Expect(condition.Severity).To(Equal(infrastructurev1alpha1.ConditionSeverityError))
+					Expect(condition.Message).To(ContainSubstring(message))
+					Expect(condition.Message).To(ContainSubstring("remove the finalizer " + infrastructurev1alpha1.MachineFinalizer))
+				}
+			}
+
+			By("referencing a credentials Secret which does not exist")
+			stackitCluster := &infrastructurev1alpha1.StackitCluster{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: clusterName, Namespace: namespace}, stackitCluster)).To(Succeed())
+			stackitCluster.Spec.IdentityRef = &infrastructurev1alpha1.StackitIdentityReference{
+				Kind: infrastructurev1alpha1.SecretIdentityKind,
+				Name: "machine-test-credentials",
+			}
+			Expect(k8sClient.Update(ctx, stackitCluster)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).To(MatchError(ContainSubstring("getting credentials secret")))
+			expectDeletionBlocked("getting credentials secret")
+
+			By("deleting the StackitCluster")
+			Expect(k8sClient.Delete(ctx, stackitCluster)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).To(MatchError(ContainSubstring("does not exist")))
+			expectDeletionBlocked("the StackitCluster of cluster " + clusterName + " does not exist")
+		})
+
+		It("should select the newest image matching the image selector", func() {
+			By("publishing images for several Kubernetes versions")
+			created := time.Now().Add(-time.Hour)
+			image := func(name, kubernetesVersion, distro string, age time.Duration) stackit.ImageModel {
+				return stackit.ImageModel{
+					Name:      name,
+					Labels:    map[string]string{infrastructurev1alpha1.ImageKubernetesVersionLabel: kubernetesVersion},
+					Config:    &stackit.ImageConfigModel{OperatingSystemDistro: distro, OperatingSystemVersion: "22.04"},
+					CreatedAt: ptr.To(created.Add(-age)),
+				}
+			}
+			stackitAPI.AddImage(image("capi-ubuntu-2204-v1.31.2-1", "v1.31.2", "ubuntu", 2*time.Hour))
+			wantID := stackitAPI.AddImage(image("capi-ubuntu-2204-v1.31.2-2", "1.31.2", "Ubuntu", time.Hour))
+			stackitAPI.AddImage(image("capi-ubuntu-2204-v1.32.0-1", "v1.32.0", "ubuntu", 0))
+			stackitAPI.AddImage(image("capi-flatcar-v1.31.2-1", "v1.31.2", "flatcar", 0))
+			pending := image("capi-ubuntu-2204-v1.31.2-3", "v1.31.2", "ubuntu", 0)
+			pending.Status = "CREATING"
+			stackitAPI.AddImage(pending)
+
+			resource := &infrastructurev1alpha1.StackitMachine{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.Image = infrastructurev1alpha1.ImageSpec{Selector: &infrastructurev1alpha1.ImageSelector{
+				NamePattern:            "capi-*",
+				MatchKubernetesVersion: true,
+				OS:                     &infrastructurev1alpha1.ImageOSSelector{Distro: "ubuntu", Version: "22.04"},
+			}}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: machineName, Namespace: namespace},
+				Data:       map[string][]byte{"value": []byte("#cloud-config\n")},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			machine.Spec.Bootstrap.DataSecretName = ptr.To(secret.Name)
+			machine.Spec.Version = ptr.To("v1.30.0")
+			Expect(k8sClient.Update(ctx, machine)).To(Succeed())
+
+			By("failing while no image exists for the Kubernetes version")
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).To(MatchError(ContainSubstring("no available image for Kubernetes v1.30.0")))
+
+			By("creating the server from the newest matching image")
+			machine.Spec.Version = ptr.To("v1.31.2")
+			Expect(k8sClient.Update(ctx, machine)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.ImageID).To(Equal(wantID))
+			serverID, err := cloud.ServerIDFromProviderID(*resource.Spec.ProviderID)
+			Expect(err).NotTo(HaveOccurred())
+			api, err := cloudFactory(ctx, cloud.Scope{ProjectID: projectID, Region: "eu01"})
+			Expect(err).NotTo(HaveOccurred())
+			server, err := api.Servers().Get(ctx, serverID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.ImageID).To(Equal(wantID))
+
+			By("deleting the resource")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+			}).Should(Succeed())
+		})
+
+		It("should pass the bootstrap data as user data", func() {
+			ignition := []byte(`{"ignition":{"version":"3.4.0"}}`)
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: machineName, Namespace: namespace},
+				Data:       map[string][]byte{"value": ignition},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			machine.Spec.Bootstrap.DataSecretName = ptr.To(secret.Name)
+			Expect(k8sClient.Update(ctx, machine)).To(Succeed())
+
+			By("rejecting an unsupported format")
+			secret.Data["format"] = []byte("talos")
+			Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).To(MatchError(ContainSubstring(`unsupported format "talos"`)))
+
+			By("rejecting bootstrap data exceeding the user data limit before creating volumes")
+			resource := &infrastructurev1alpha1.StackitMachine{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.AdditionalVolumes = []infrastructurev1alpha1.AdditionalVolumeSpec{{DeviceName: "etcd", Size: 10}}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			secret.Data = map[string][]byte{"value": make([]byte, cloud.MaxUserDataSize), "format": []byte("cloud-config")}
+			Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.AdditionalVolumes).To(BeEmpty())
+			condition := conditions.Get(resource, infrastructurev1alpha1.BootstrapDataAvailableCondition)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(infrastructurev1alpha1.BootstrapDataTooLargeReason))
+			Expect(condition.Severity).To(Equal(infrastructurev1alpha1.ConditionSeverityError))
+			Expect(condition.Message).To(ContainSubstring("cloud-config bootstrap data"))
+			Expect(stackitAPI.Servers()).To(BeEmpty())
+
+			By("passing an Ignition config without format")
+			secret.Data = map[string][]byte{"value": ignition}
+			Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(conditions.IsTrue(resource, infrastructurev1alpha1.BootstrapDataAvailableCondition)).To(BeTrue())
+			serverID, err := cloud.ServerIDFromProviderID(*resource.Spec.ProviderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stackitAPI.UserData(serverID)).To(Equal(ignition))
+
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+			}).Should(Succeed())
+		})
+
+		It("should create, attach and delete additional volumes", func() {
+			resource := &infrastructurev1alpha1.StackitMachine{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.AvailabilityZone = "eu01-1"
+			resource.Spec.AdditionalVolumes = []infrastructurev1alpha1.AdditionalVolumeSpec{
+				{
+					DeviceName:       "etcd",
+					Size:             10,
+					PerformanceClass: "storage_premium_perf6",
+					Encryption: &infrastructurev1alpha1.VolumeEncryptionSpec{
+						KeyRingID:      "key-ring",
+						KeyID:          "key",
+						KeyVersion:     1,
+						ServiceAccount: "volumes@sa.stackit.cloud",
+					},
+				},
+				{DeviceName: "containerd", Size: 50, DeleteOnTermination: ptr.To(false)},
+			}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: machineName, Namespace: namespace},
+				Data:       map[string][]byte{"value": []byte("#cloud-config\n")},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			machine.Spec.Bootstrap.DataSecretName = ptr.To(secret.Name)
+			Expect(k8sClient.Update(ctx, machine)).To(Succeed())
+
+			By("creating the volumes before the server")
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Ready).To(BeTrue())
+			Expect(resource.Status.AdditionalVolumes).To(HaveExactElements(
+				HaveField("DeviceName", "etcd"),
+				HaveField("DeviceName", "containerd"),
+			))
+			etcdID, containerdID := resource.Status.AdditionalVolumes[0].ID, resource.Status.AdditionalVolumes[1].ID
+
+			api, err := cloudFactory(ctx, cloud.Scope{ProjectID: projectID, Region: "eu01"})
+			Expect(err).NotTo(HaveOccurred())
+			etcd, err := api.Volumes().Get(ctx, etcdID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(etcd.Name).To(Equal(machineName + "-etcd"))
+			Expect(etcd.Size).To(BeEquivalentTo(10))
+			Expect(etcd.PerformanceClass).To(Equal("storage_premium_perf6"))
+			Expect(etcd.AvailabilityZone).To(Equal("eu01-1"))
+			Expect(etcd.Encrypted).To(BeTrue())
+
+			serverID, err := cloud.ServerIDFromProviderID(*resource.Spec.ProviderID)
+			Expect(err).NotTo(HaveOccurred())
+			server, err := api.Servers().Get(ctx, serverID)
 			Expect(err).NotTo(HaveOccurred())
+			Expect(server.VolumeIDs).To(ContainElements(etcdID, containerdID))
 
-			By("Cleanup the specific resource instance StackitMachine")
+			By("deleting the volumes not kept on termination")
 			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+			}).Should(Succeed())
+			Eventually(func() error {
+				_, err := api.Volumes().Get(ctx, etcdID)
+				return err
+			}).Should(Satisfy(cloud.IsNotFound))
+			containerd, err := api.Volumes().Get(ctx, containerdID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(containerd.ServerID).To(BeEmpty())
+			Expect(containerd.Labels).NotTo(HaveKey(cloud.MachineUIDLabel))
+			Expect(containerd.Labels).To(HaveKey(cloud.ClusterUIDLabel))
+			Expect(api.Volumes().Delete(ctx, containerdID)).To(Succeed())
+		})
+
+		It("should create additional volumes in the zone of the server without an availability zone", func() {
+			resource := &infrastructurev1alpha1.StackitMachine{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.AdditionalVolumes = []infrastructurev1alpha1.AdditionalVolumeSpec{{DeviceName: "etcd", Size: 10}}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: machineName, Namespace: namespace},
+				Data:       map[string][]byte{"value": []byte("#cloud-config\n")},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			machine.Spec.Bootstrap.DataSecretName = ptr.To(secret.Name)
+			Expect(k8sClient.Update(ctx, machine)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Ready).To(BeTrue())
+			Expect(resource.Status.AdditionalVolumes).To(HaveLen(1))
+
+			api, err := cloudFactory(ctx, cloud.Scope{ProjectID: projectID, Region: "eu01"})
+			Expect(err).NotTo(HaveOccurred())
+			volume, err := api.Volumes().Get(ctx, resource.Status.AdditionalVolumes[0].ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(volume.AvailabilityZone).To(Equal("eu01-1"))
+			serverID, err := cloud.ServerIDFromProviderID(*resource.Spec.ProviderID)
+			Expect(err).NotTo(HaveOccurred())
+			server, err := api.Servers().Get(ctx, serverID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.AvailabilityZone).To(Equal("eu01-1"))
+			Expect(server.VolumeIDs).To(ContainElement(volume.ID))
+
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+			}).Should(Succeed())
+		})
+
+		It("should offload Ignition configs to object storage", func() {
+			By("configuring the bucket")
+			objectStorageAPI.CreateBucket("bootstrap")
+			credentials := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "object-storage", Namespace: namespace},
+				Data: map[string][]byte{
+					cloud.AccessKeyIDSecretKey:     []byte(objectStorageAccessKeyID),
+					cloud.SecretAccessKeySecretKey: []byte("secret"),
+				},
+			}
+			Expect(k8sClient.Create(ctx, credentials)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, credentials)
+			resource := &infrastructurev1alpha1.StackitMachine{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.Ignition = &infrastructurev1alpha1.IgnitionSpec{
+				ObjectStorage: infrastructurev1alpha1.ObjectStorageSpec{
+					Bucket:            "bootstrap",
+					Endpoint:          objectStorageAPI.URL(),
+					CredentialsSecret: corev1.LocalObjectReference{Name: credentials.Name},
+				},
+			}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			By("providing an Ignition config exceeding the user data limit")
+			config := []byte(`{"ignition":{"version":"3.4.0"},"storage":{"files":[{"path":"/etc/large",` +
+				`"contents":{"source":"data:,` + strings.Repeat("x", cloud.MaxUserDataSize) + `"}}]}}`)
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: machineName, Namespace: namespace},
+				Data:       map[string][]byte{"value": config, "format": []byte("ignition")},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			machine.Spec.Bootstrap.DataSecretName = ptr.To(secret.Name)
+			Expect(k8sClient.Update(ctx, machine)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Ready).To(BeTrue())
+			Expect(conditions.IsTrue(resource, infrastructurev1alpha1.BootstrapDataAvailableCondition)).To(BeTrue())
+			key := resource.Status.IgnitionObjectKey
+			Expect(key).To(Equal(ignitionObjectKey(resource)))
+			uploaded, ok := objectStorageAPI.Object("bootstrap", key)
+			Expect(ok).To(BeTrue())
+			Expect(uploaded).To(Equal(config))
+
+			By("passing a config replacing itself with the uploaded one")
+			serverID, err := cloud.ServerIDFromProviderID(*resource.Spec.ProviderID)
+			Expect(err).NotTo(HaveOccurred())
+			var stub struct {
+				Ignition struct {
+					Version string `json:"version"`
+					Config  struct {
+						Replace struct {
+							Source string `json:"source"`
+						} `json:"replace"`
+					} `json:"config"`
+				} `json:"ignition"`
+			}
+			Expect(json.Unmarshal(stackitAPI.UserData(serverID), &stub)).To(Succeed())
+			Expect(stub.Ignition.Version).To(Equal("3.4.0"))
+			resp, err := http.Get(stub.Ignition.Config.Replace.Source)
+			Expect(err).NotTo(HaveOccurred())
+			defer func() { _ = resp.Body.Close() }()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(io.ReadAll(resp.Body)).To(Equal(config))
+
+			By("keeping the object until the node has joined")
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objectStorageAPI.Objects("bootstrap")).To(ContainElement(key))
+
+			machine.Status.NodeRef = &corev1.ObjectReference{Kind: "Node", Name: machineName}
+			Expect(k8sClient.Status().Update(ctx, machine)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objectStorageAPI.Objects("bootstrap")).NotTo(ContainElement(key))
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.IgnitionObjectKey).To(BeEmpty())
+
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+			}).Should(Succeed())
+		})
+
+		It("should allocate or reuse a public IP", func() {
+			api, err := cloudFactory(ctx, cloud.Scope{ProjectID: projectID, Region: "eu01"})
+			Expect(err).NotTo(HaveOccurred())
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: machineName, Namespace: namespace},
+				Data:       map[string][]byte{"value": []byte("#cloud-config\n")},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			machine.Spec.Bootstrap.DataSecretName = ptr.To(secret.Name)
+			Expect(k8sClient.Update(ctx, machine)).To(Succeed())
+
+			By("allocating a public IP")
+			resource := &infrastructurev1alpha1.StackitMachine{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.PublicIP = &infrastructurev1alpha1.PublicIPSpec{}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Ready).To(BeTrue())
+			Expect(resource.Status.PublicIP).NotTo(BeNil())
+			Expect(resource.Status.PublicIP.Unmanaged).To(BeFalse())
+			Expect(resource.Status.Addresses).To(ContainElement(infrastructurev1alpha1.MachineAddress{
+				Type:    infrastructurev1alpha1.MachineExternalIP,
+				Address: resource.Status.PublicIP.Address,
+			}))
+			ip, err := api.PublicIPs().Get(ctx, resource.Status.PublicIP.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ip.NetworkInterface).NotTo(BeEmpty())
+
+			By("releasing the public IP on deletion")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+			}).Should(Succeed())
+			_, err = api.PublicIPs().Get(ctx, ip.ID)
+			Expect(cloud.IsNotFound(err)).To(BeTrue())
+
+			By("reusing a pre-allocated public IP")
+			reserved, err := api.PublicIPs().Create(ctx, cloud.CreatePublicIPRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(func() { Expect(api.PublicIPs().Delete(ctx, reserved.ID)).To(Succeed()) })
+			resource = &infrastructurev1alpha1.StackitMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      machineName,
+					Namespace: namespace,
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Machine",
+						Name:       machine.Name,
+						UID:        machine.UID,
+					}},
+				},
+				Spec: infrastructurev1alpha1.StackitMachineSpec{
+					MachineType: "c1.2",
+					Image:       infrastructurev1alpha1.ImageSpec{Name: ptr.To("ubuntu-22.04")},
+					BootVolume:  infrastructurev1alpha1.BootVolumeSpec{Size: 20},
+					PublicIP:    &infrastructurev1alpha1.PublicIPSpec{ID: reserved.ID},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.PublicIP).To(HaveValue(Equal(infrastructurev1alpha1.PublicIPStatus{
+				ID:        reserved.ID,
+				Address:   reserved.IP,
+				Unmanaged: true,
+			})))
+
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+			}).Should(Succeed())
+			ip, err = api.PublicIPs().Get(ctx, reserved.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ip.NetworkInterface).To(BeEmpty())
+		})
+
+		It("should register control plane machines at the API server load balancer", func() {
+			By("creating the API server load balancer")
+			api, err := cloudFactory(ctx, cloud.Scope{ProjectID: projectID, Region: "eu01"})
+			Expect(err).NotTo(HaveOccurred())
+			lb, err := api.LoadBalancers().Create(ctx, cloud.LoadBalancer{
+				Name:        "machine-test-kubeapi",
+				NetworkID:   networkID,
+				Listeners:   []cloud.Listener{{Name: "kubeapi", Port: 6443, TargetPool: apiServerTargetPool}},
+				TargetPools: []cloud.TargetPool{{Name: apiServerTargetPool, TargetPort: 6443}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(func() { Expect(api.LoadBalancers().Delete(ctx, lb.Name)).To(Succeed()) })
+			group, err := api.ServerGroups().Create(ctx, cloud.CreateServerGroupRequest{
+				Name:   "machine-test-control-plane",
+				Policy: cloud.ServerGroupPolicyAntiAffinity,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(func() { Expect(api.ServerGroups().Delete(ctx, group.ID)).To(Succeed()) })
+
+			stackitCluster := &infrastructurev1alpha1.StackitCluster{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: clusterName, Namespace: namespace}, stackitCluster)).To(Succeed())
+			stackitCluster.Status.APIServerLoadBalancer = &infrastructurev1alpha1.LoadBalancerStatus{Name: lb.Name}
+			stackitCluster.Status.ControlPlaneServerGroupID = group.ID
+			Expect(k8sClient.Status().Update(ctx, stackitCluster)).To(Succeed())
+
+			By("creating a control plane machine")
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: machineName, Namespace: namespace},
+				Data:       map[string][]byte{"value": []byte("#cloud-config\n")},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			machine.Labels[clusterv1.MachineControlPlaneLabel] = ""
+			machine.Spec.Bootstrap.DataSecretName = ptr.To(secret.Name)
+			Expect(k8sClient.Update(ctx, machine)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			resource := &infrastructurev1alpha1.StackitMachine{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Ready).To(BeTrue())
+			Expect(conditions.IsTrue(resource, infrastructurev1alpha1.APIServerTargetRegisteredCondition)).To(BeTrue())
+			Expect(conditions.IsTrue(resource, infrastructurev1alpha1.ReadyCondition)).To(BeTrue())
+			var internalIP string
+			for _, address := range resource.Status.Addresses {
+				if address.Type == infrastructurev1alpha1.MachineInternalIP {
+					internalIP = address.Address
+				}
+			}
+			lb, err = api.LoadBalancers().Get(ctx, lb.Name)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lb.TargetPools[0].Targets).To(ConsistOf(cloud.Target{DisplayName: machineName, IP: internalIP}))
+
+			By("waiting for the network of a reset StackitCluster status")
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: clusterName, Namespace: namespace}, stackitCluster)).To(Succeed())
+			networkStatus := stackitCluster.Status.Network
+			stackitCluster.Status.Network = nil
+			Expect(k8sClient.Status().Update(ctx, stackitCluster)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).To(MatchError(ContainSubstring("the network of StackitCluster machine-test is not known yet")))
+			stackitCluster.Status.Network = networkStatus
+			Expect(k8sClient.Status().Update(ctx, stackitCluster)).To(Succeed())
+
+			By("placing the server in the control plane server group")
+			Expect(resource.Status.ServerGroupID).To(Equal(group.ID))
+			group, err = api.ServerGroups().Get(ctx, group.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.MemberIDs).To(HaveLen(1))
+
+			By("deleting the machine")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			lb, err = api.LoadBalancers().Get(ctx, lb.Name)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lb.TargetPools[0].Targets).To(BeEmpty())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+			}).Should(Succeed())
+		})
+
+		It("should create and delete a server group named in the spec", func() {
+			resource := &infrastructurev1alpha1.StackitMachine{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.ServerGroup = &infrastructurev1alpha1.ServerGroupSpec{Name: "md-0"}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: machineName, Namespace: namespace},
+				Data:       map[string][]byte{"value": []byte("#cloud-config\n")},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			machine.Spec.Bootstrap.DataSecretName = ptr.To(secret.Name)
+			Expect(k8sClient.Update(ctx, machine)).To(Succeed())
+
+			By("creating the server in the server group")
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Ready).To(BeTrue())
+			Expect(resource.Status.ServerGroupID).NotTo(BeEmpty())
+
+			api, err := cloudFactory(ctx, cloud.Scope{ProjectID: projectID, Region: "eu01"})
+			Expect(err).NotTo(HaveOccurred())
+			group, err := api.ServerGroups().Get(ctx, resource.Status.ServerGroupID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Name).To(Equal(namespace + "-" + clusterName + "-md-0"))
+			Expect(group.Policy).To(Equal(cloud.ServerGroupPolicyAntiAffinity))
+			serverID, err := cloud.ServerIDFromProviderID(*resource.Spec.ProviderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.MemberIDs).To(ConsistOf(serverID))
+
+			By("deleting the server group with its last member")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+			}).Should(Succeed())
+			_, err = api.ServerGroups().Get(ctx, group.ID)
+			Expect(cloud.IsNotFound(err)).To(BeTrue())
 		})
-		It("should successfully reconcile the resource", func() {
-			By("Reconciling the created resource")
-			controllerReconciler := &StackitMachineReconciler{
-				Client: k8sClient,
-				Scheme: k8sClient.Scheme(),
+
+		It("should keep a server group named by another machine without a server", func() {
+			const siblingName = machineName + "-sibling"
+			siblingNamespacedName := types.NamespacedName{Name: siblingName, Namespace: namespace}
+
+			resource := &infrastructurev1alpha1.StackitMachine{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.ServerGroup = &infrastructurev1alpha1.ServerGroupSpec{Name: "md-0"}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: machineName, Namespace: namespace},
+				Data:       map[string][]byte{"value": []byte("#cloud-config\n")},
 			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			machine.Spec.Bootstrap.DataSecretName = ptr.To(secret.Name)
+			Expect(k8sClient.Update(ctx, machine)).To(Succeed())
 
-			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
-				NamespacedName: typeNamespacedName,
+			By("creating a sibling machine in the same server group waiting for its bootstrap data")
+			sibling := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      siblingName,
+					Namespace: namespace,
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: clusterName},
+				},
+				Spec: clusterv1.MachineSpec{
+					ClusterName: clusterName,
+					InfrastructureRef: corev1.ObjectReference{
+						APIVersion: infrastructurev1alpha1.GroupVersion.String(),
+						Kind:       "StackitMachine",
+						Name:       siblingName,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, sibling)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, sibling))).To(Succeed())
+			})
+			siblingResource := &infrastructurev1alpha1.StackitMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      siblingName,
+					Namespace: namespace,
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Machine",
+						Name:       sibling.Name,
+						UID:        sibling.UID,
+					}},
+				},
+				Spec: *resource.Spec.DeepCopy(),
+			}
+			Expect(k8sClient.Create(ctx, siblingResource)).To(Succeed())
+			DeferCleanup(func() {
+				if err := k8sClient.Get(ctx, siblingNamespacedName, siblingResource); err == nil {
+					controllerutil.RemoveFinalizer(siblingResource, infrastructurev1alpha1.MachineFinalizer)
+					Expect(k8sClient.Update(ctx, siblingResource)).To(Succeed())
+					Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, siblingResource))).To(Succeed())
+				}
 			})
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: siblingNamespacedName})
 			Expect(err).NotTo(HaveOccurred())
-			// TODO(user): Add more specific assertions depending on your controller's reconciliation logic.
-			// Example: If you expect a certain status condition after reconciliation, verify it here.
+
+			By("creating the server of the first machine in the server group")
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			groupID := resource.Status.ServerGroupID
+			Expect(groupID).NotTo(BeEmpty())
+
+			By("keeping the server group when the first machine is deleted")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+			}).Should(Succeed())
+			api, err := cloudFactory(ctx, cloud.Scope{ProjectID: projectID, Region: "eu01"})
+			Expect(err).NotTo(HaveOccurred())
+			group, err := api.ServerGroups().Get(ctx, groupID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.MemberIDs).To(BeEmpty())
+
+			By("creating the server of the sibling in the same server group")
+			sibling.Spec.Bootstrap.DataSecretName = ptr.To(secret.Name)
+			Expect(k8sClient.Update(ctx, sibling)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: siblingNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, siblingNamespacedName, siblingResource)).To(Succeed())
+			Expect(siblingResource.Status.Ready).To(BeTrue())
+			Expect(siblingResource.Status.ServerGroupID).To(Equal(groupID))
+
+			By("deleting the server group with the last machine")
+			Expect(k8sClient.Delete(ctx, siblingResource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: siblingNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(errors.IsNotFound(k8sClient.Get(ctx, siblingNamespacedName, siblingResource))).To(BeTrue())
+			}).Should(Succeed())
+			_, err = api.ServerGroups().Get(ctx, groupID)
+			Expect(cloud.IsNotFound(err)).To(BeTrue())
 		})
 	})
 })
@@ -0,0 +1,558 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	clusterv1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/capi/v1beta1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/conditions"
+)
+
+// StackitMachinePoolReconciler reconciles a StackitMachinePool object
+type StackitMachinePoolReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// CloudFactory creates the STACKIT clients used to manage the infrastructure.
+	CloudFactory cloud.Factory
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitmachinepools,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitmachinepools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitmachinepools/finalizers,verbs=update
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools,verbs=get;list;watch
+
+// Reconcile keeps the number of servers of a StackitMachinePool at the
+// replicas of the owning MachinePool, replaces servers created from a
+// previous template, and deletes the servers when the StackitMachinePool is
+// deleted.
+func (r *StackitMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := logf.FromContext(ctx)
+
+	stackitMachinePool := &infrastructurev1alpha1.StackitMachinePool{}
+	if err := r.Get(ctx, req.NamespacedName, stackitMachinePool); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	machinePool, err := getOwnerMachinePool(ctx, r.Client, stackitMachinePool.ObjectMeta)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if machinePool == nil {
+		log.Info("Waiting for the MachinePool controller to set the owner reference")
+		return ctrl.Result{}, nil
+	}
+	log = log.WithValues("machinePool", machinePool.Name)
+
+	cluster, err := getCluster(ctx, r.Client, machinePool.Namespace, machinePool.Spec.ClusterName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	log = log.WithValues("cluster", cluster.Name)
+
+	if isPaused(cluster, stackitMachinePool) {
+		log.Info("Reconciliation is paused")
+		return ctrl.Result{}, nil
+	}
+
+	stackitCluster, err := getStackitCluster(ctx, r.Client, cluster)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if stackitCluster == nil {
+		log.Info("Waiting for the Cluster infrastructureRef to be set")
+		return ctrl.Result{}, nil
+	}
+	ctx = logf.IntoContext(ctx, log)
+
+	before := stackitMachinePool.DeepCopy()
+	defer func() {
+		conditions.SetSummary(stackitMachinePool, infrastructurev1alpha1.InstancesReadyCondition)
+		if err := patchObject(ctx, r.Client, before, stackitMachinePool); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	scope, err := cloudScope(ctx, r.Client, stackitCluster)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	stackit, err := r.CloudFactory(ctx, scope)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("creating STACKIT client: %w", err)
+	}
+
+	if !stackitMachinePool.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, stackit, stackitMachinePool)
+	}
+	return r.reconcileNormal(ctx, stackit, cluster, machinePool, stackitCluster, stackitMachinePool)
+}
+
+func (r *StackitMachinePoolReconciler) reconcileNormal(
+	ctx context.Context,
+	stackit cloud.Interface,
+	cluster *clusterv1.Cluster,
+	machinePool *clusterv1.MachinePool,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+	stackitMachinePool *infrastructurev1alpha1.StackitMachinePool,
+) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	controllerutil.AddFinalizer(stackitMachinePool, infrastructurev1alpha1.MachinePoolFinalizer)
+
+	if !cluster.Status.InfrastructureReady {
+		log.Info("Waiting for the cluster infrastructure to be ready")
+		conditions.MarkFalse(stackitMachinePool, infrastructurev1alpha1.InstancesReadyCondition,
+			infrastructurev1alpha1.WaitingForClusterInfrastructureReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+		return ctrl.Result{}, nil
+	}
+
+	hash, err := machinePoolTemplateHash(machinePool, stackitMachinePool)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	servers, err := stackit.Servers().List(ctx, map[string]string{cloud.MachinePoolUIDLabel: string(stackitMachinePool.UID)})
+	if err != nil {
+		conditions.MarkFalse(stackitMachinePool, infrastructurev1alpha1.InstancesReadyCondition,
+			infrastructurev1alpha1.InstanceReconciliationFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+		return ctrl.Result{}, fmt.Errorf("listing servers: %w", err)
+	}
+	replicas := ptr.Deref(machinePool.Spec.Replicas, 1)
+	plan := planMachinePool(servers, hash, int(replicas), stackitMachinePool.Spec.Strategy)
+
+	for _, server := range plan.delete {
+		log.Info("Deleting server", "serverID", server.ID, "state", server.Status)
+		if err := stackit.Servers().Delete(ctx, server.ID); err != nil && !cloud.IsNotFound(err) {
+			conditions.MarkFalse(stackitMachinePool, infrastructurev1alpha1.InstancesReadyCondition,
+				infrastructurev1alpha1.InstanceReconciliationFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+			return ctrl.Result{}, fmt.Errorf("deleting server %s: %w", server.ID, err)
+		}
+	}
+	servers = plan.keep
+	setMachinePoolStatus(stackitMachinePool, servers, hash, replicas)
+
+	if plan.create > 0 {
+		created, err := r.createServers(ctx, stackit, machinePool, stackitCluster, stackitMachinePool, servers, hash, plan.create)
+		if err != nil {
+			conditions.MarkFalse(stackitMachinePool, infrastructurev1alpha1.InstancesReadyCondition,
+				infrastructurev1alpha1.InstanceProvisionFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+		}
+		// Servers created before an error are part of the pool as well.
+		servers = slices.Concat(servers, created)
+		setMachinePoolStatus(stackitMachinePool, servers, hash, replicas)
+		if err != nil || created == nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	status := stackitMachinePool.Status
+	var upToDateReady int32
+	for _, server := range servers {
+		if server.Status == cloud.ServerStatusActive && server.Labels[cloud.TemplateHashLabel] == hash {
+			upToDateReady++
+		}
+	}
+	if len(plan.delete) == 0 && status.Replicas == replicas && upToDateReady == replicas {
+		conditions.MarkTrue(stackitMachinePool, infrastructurev1alpha1.InstancesReadyCondition)
+		return ctrl.Result{}, nil
+	}
+
+	reason := infrastructurev1alpha1.InstanceProvisioningReason
+	switch {
+	case status.UpToDateReplicas < status.Replicas:
+		reason = infrastructurev1alpha1.RollingUpdateInProgressReason
+	case status.Replicas < replicas:
+		reason = infrastructurev1alpha1.ScalingUpReason
+	case status.Replicas > replicas:
+		reason = infrastructurev1alpha1.ScalingDownReason
+	}
+	log.Info("Waiting for the servers to converge", "reason", reason, "replicas", status.Replicas,
+		"readyReplicas", status.ReadyReplicas, "upToDateReplicas", status.UpToDateReplicas, "desiredReplicas", replicas)
+	conditions.MarkFalse(stackitMachinePool, infrastructurev1alpha1.InstancesReadyCondition,
+		reason, infrastructurev1alpha1.ConditionSeverityInfo,
+		"%d of %d servers are running the current template", upToDateReady, replicas)
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *StackitMachinePoolReconciler) reconcileDelete(
+	ctx context.Context,
+	stackit cloud.Interface,
+	stackitMachinePool *infrastructurev1alpha1.StackitMachinePool,
+) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	conditions.MarkFalse(stackitMachinePool, infrastructurev1alpha1.InstancesReadyCondition,
+		infrastructurev1alpha1.DeletingReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+
+	servers, err := stackit.Servers().List(ctx, map[string]string{cloud.MachinePoolUIDLabel: string(stackitMachinePool.UID)})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("listing servers: %w", err)
+	}
+	if len(servers) > 0 {
+		stackitMachinePool.Status.Ready = false
+		stackitMachinePool.Status.Replicas = int32(len(servers))
+		for _, server := range servers {
+			if server.Status == cloud.ServerStatusDeleting {
+				continue
+			}
+			log.Info("Deleting server", "serverID", server.ID)
+			if err := stackit.Servers().Delete(ctx, server.ID); err != nil && !cloud.IsNotFound(err) {
+				return ctrl.Result{}, fmt.Errorf("deleting server %s: %w", server.ID, err)
+			}
+		}
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	stackitMachinePool.Spec.ProviderIDList = nil
+	stackitMachinePool.Status.Replicas = 0
+	stackitMachinePool.Status.ReadyReplicas = 0
+	stackitMachinePool.Status.UpToDateReplicas = 0
+	controllerutil.RemoveFinalizer(stackitMachinePool, infrastructurev1alpha1.MachinePoolFinalizer)
+	return ctrl.Result{}, nil
+}
+
+// machinePoolPlan is what the reconciler does to converge the servers of a
+// StackitMachinePool.
+type machinePoolPlan struct {
+	// keep are the servers remaining in the pool.
+	keep []cloud.Server
+	// delete are the servers to delete.
+	delete []cloud.Server
+	// create is the number of servers to create.
+	create int
+}
+
+// planMachinePool plans how to converge servers to replicas servers created
+// from the template identified by hash. Failed servers are replaced. Servers
+// created from a previous template are replaced by creating new servers
+// first, without exceeding the limits of strategy. Servers which are not
+// running are deleted first.
+func planMachinePool(
+	servers []cloud.Server,
+	hash string,
+	replicas int,
+	strategy infrastructurev1alpha1.MachinePoolStrategy,
+) machinePoolPlan {
+	maxSurge := int(ptr.Deref(strategy.MaxSurge, infrastructurev1alpha1.DefaultMaxSurge))
+	maxUnavailable := int(ptr.Deref(strategy.MaxUnavailable, 0))
+
+	var plan machinePoolPlan
+	var upToDate, outdated []cloud.Server
+	available := 0
+	for _, server := range servers {
+		switch server.Status {
+		case cloud.ServerStatusDeleting:
+			continue
+		case cloud.ServerStatusError:
+			plan.delete = append(plan.delete, server)
+			continue
+		case cloud.ServerStatusActive:
+			available++
+		}
+		if server.Labels[cloud.TemplateHashLabel] == hash {
+			upToDate = append(upToDate, server)
+		} else {
+			outdated = append(outdated, server)
+		}
+	}
+	byAvailability := func(a, b cloud.Server) int {
+		return cmp.Or(
+			cmp.Compare(boolRank(a.Status == cloud.ServerStatusActive), boolRank(b.Status == cloud.ServerStatusActive)),
+			cmp.Compare(a.ID, b.ID),
+		)
+	}
+	slices.SortFunc(upToDate, byAvailability)
+	slices.SortFunc(outdated, byAvailability)
+
+	if len(outdated) == 0 {
+		if surplus := len(upToDate) - replicas; surplus > 0 {
+			plan.delete = append(plan.delete, upToDate[:surplus]...)
+			upToDate = upToDate[surplus:]
+		}
+		plan.create = max(replicas-len(upToDate), 0)
+		plan.keep = upToDate
+		return plan
+	}
+
+	plan.create = max(min(replicas-len(upToDate), replicas+maxSurge-len(upToDate)-len(outdated)), 0)
+	deletable := available - (replicas - maxUnavailable)
+	plan.keep = upToDate
+	for _, server := range outdated {
+		// Servers which are not running do not count as available.
+		if server.Status == cloud.ServerStatusActive {
+			if deletable <= 0 {
+				plan.keep = append(plan.keep, server)
+				continue
+			}
+			deletable--
+		}
+		plan.delete = append(plan.delete, server)
+	}
+	return plan
+}
+
+func boolRank(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// setMachinePoolStatus records servers, the servers of the pool, in the
+// provider ID list and status of stackitMachinePool.
+func setMachinePoolStatus(
+	stackitMachinePool *infrastructurev1alpha1.StackitMachinePool,
+	servers []cloud.Server,
+	hash string,
+	replicas int32,
+) {
+	providerIDs := make([]string, 0, len(servers))
+	var ready, upToDate int32
+	for _, server := range servers {
+		providerIDs = append(providerIDs, cloud.ProviderID(server.ID))
+		if server.Status == cloud.ServerStatusActive {
+			ready++
+		}
+		if server.Labels[cloud.TemplateHashLabel] == hash {
+			upToDate++
+		}
+	}
+	slices.Sort(providerIDs)
+	stackitMachinePool.Spec.ProviderIDList = providerIDs
+	stackitMachinePool.Status.TemplateHash = hash
+	stackitMachinePool.Status.Replicas = int32(len(servers))
+	stackitMachinePool.Status.ReadyReplicas = ready
+	stackitMachinePool.Status.UpToDateReplicas = upToDate
+	if ready >= replicas {
+		stackitMachinePool.Status.Ready = true
+	}
+}
+
+// machinePoolTemplateHash returns the hash identifying the servers created
+// from the current template of stackitMachinePool for the Kubernetes version
+// and bootstrap data secret of machinePool.
+func machinePoolTemplateHash(
+	machinePool *clusterv1.MachinePool,
+	stackitMachinePool *infrastructurev1alpha1.StackitMachinePool,
+) (string, error) {
+	data, err := json.Marshal(struct {
+		Template       infrastructurev1alpha1.StackitMachinePoolTemplate `json:"template"`
+		Version        *string                                           `json:"version,omitempty"`
+		DataSecretName *string                                           `json:"dataSecretName,omitempty"`
+	}{
+		Template:       stackitMachinePool.Spec.Template,
+		Version:        machinePool.Spec.Template.Spec.Version,
+		DataSecretName: machinePool.Spec.Template.Spec.Bootstrap.DataSecretName,
+	})
+	if err != nil {
+		return "", fmt.Errorf("hashing the template: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:10], nil
+}
+
+// createServers creates count servers from the current template, spread
+// across the failure domains of machinePool. It returns nil without an error
+// if the bootstrap data is not available.
+func (r *StackitMachinePoolReconciler) createServers(
+	ctx context.Context,
+	stackit cloud.Interface,
+	machinePool *clusterv1.MachinePool,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+	stackitMachinePool *infrastructurev1alpha1.StackitMachinePool,
+	servers []cloud.Server,
+	hash string,
+	count int,
+) ([]cloud.Server, error) {
+	log := logf.FromContext(ctx)
+
+	userData, err := r.getUserData(ctx, machinePool, stackitMachinePool)
+	if userData == nil || err != nil {
+		return nil, err
+	}
+
+	if stackitCluster.Status.Network == nil {
+		return nil, fmt.Errorf("the network of StackitCluster %s is not known yet", stackitCluster.Name)
+	}
+	for _, zone := range machinePool.Spec.FailureDomains {
+		if _, ok := stackitCluster.Status.FailureDomains[zone]; !ok {
+			return nil, fmt.Errorf("failure domain %q is not a failure domain of StackitCluster %s", zone, stackitCluster.Name)
+		}
+	}
+
+	template := stackitMachinePool.Spec.Template
+	imageID, err := newImageResolver(stackit).resolve(ctx, template.Image, ptr.Deref(machinePool.Spec.Template.Spec.Version, ""))
+	if err != nil {
+		return nil, err
+	}
+	stackitMachinePool.Status.ImageID = imageID
+
+	securityGroupIDs := slices.Clone(template.SecurityGroups)
+	if group, ok := stackitCluster.Status.SecurityGroups[infrastructurev1alpha1.SecurityGroupWorker]; ok {
+		securityGroupIDs = append(securityGroupIDs, group.ID)
+	}
+
+	var created []cloud.Server
+	for range count {
+		availabilityZone := template.AvailabilityZone
+		if len(machinePool.Spec.FailureDomains) > 0 {
+			availabilityZone = leastUsedAvailabilityZone(machinePool.Spec.FailureDomains, slices.Concat(servers, created))
+		}
+		req := cloud.CreateServerRequest{
+			Name:             stackitMachinePool.Name + "-" + utilrand.String(5),
+			MachineType:      template.MachineType,
+			ImageID:          imageID,
+			AvailabilityZone: availabilityZone,
+			KeypairName:      template.SSHKeyName,
+			NetworkID:        stackitCluster.Status.Network.ID,
+			SecurityGroupIDs: securityGroupIDs,
+			UserData:         userData,
+			Labels: map[string]string{
+				cloud.ClusterUIDLabel:     string(stackitCluster.UID),
+				cloud.MachinePoolUIDLabel: string(stackitMachinePool.UID),
+				cloud.RoleLabel:           string(infrastructurev1alpha1.SecurityGroupWorker),
+				cloud.TemplateHashLabel:   hash,
+			},
+		}
+		if bootVolume := template.BootVolume; bootVolume.Size > 0 {
+			req.BootVolume = &cloud.BootVolume{
+				Size:                bootVolume.Size,
+				PerformanceClass:    bootVolume.PerformanceClass,
+				DeleteOnTermination: true,
+			}
+		}
+		server, err := stackit.Servers().Create(ctx, req)
+		if err != nil {
+			return created, fmt.Errorf("creating server: %w", err)
+		}
+		log.Info("Created server", "serverID", server.ID, "availabilityZone", availabilityZone)
+		created = append(created, *server)
+	}
+	return created, nil
+}
+
+// getUserData returns the bootstrap data of the MachinePool to pass as user
+// data to new servers, or nil if it is not available.
+func (r *StackitMachinePoolReconciler) getUserData(
+	ctx context.Context,
+	machinePool *clusterv1.MachinePool,
+	stackitMachinePool *infrastructurev1alpha1.StackitMachinePool,
+) ([]byte, error) {
+	bootstrap := machinePool.Spec.Template.Spec.Bootstrap
+	if bootstrap.DataSecretName == nil {
+		logf.FromContext(ctx).Info("Waiting for the bootstrap data to be available")
+		conditions.MarkFalse(stackitMachinePool, infrastructurev1alpha1.BootstrapDataAvailableCondition,
+			infrastructurev1alpha1.WaitingForBootstrapDataReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+		conditions.MarkFalse(stackitMachinePool, infrastructurev1alpha1.InstancesReadyCondition,
+			infrastructurev1alpha1.WaitingForBootstrapDataReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+		return nil, nil
+	}
+	bootstrapData, err := getBootstrapData(ctx, r.Client, machinePool.Namespace, bootstrap)
+	if err != nil {
+		conditions.MarkFalse(stackitMachinePool, infrastructurev1alpha1.BootstrapDataAvailableCondition,
+			infrastructurev1alpha1.BootstrapDataUnavailableReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+		return nil, err
+	}
+	// Retrying does not help until the bootstrap data changes.
+	if size := base64.StdEncoding.EncodedLen(len(bootstrapData.value)); size > cloud.MaxUserDataSize {
+		conditions.MarkFalse(stackitMachinePool, infrastructurev1alpha1.BootstrapDataAvailableCondition,
+			infrastructurev1alpha1.BootstrapDataTooLargeReason, infrastructurev1alpha1.ConditionSeverityError,
+			"%s bootstrap data is %d bytes base64 encoded, more than the %d bytes allowed as user data",
+			bootstrapData.format, size, cloud.MaxUserDataSize)
+		conditions.MarkFalse(stackitMachinePool, infrastructurev1alpha1.InstancesReadyCondition,
+			infrastructurev1alpha1.BootstrapDataTooLargeReason, infrastructurev1alpha1.ConditionSeverityError, "")
+		return nil, nil
+	}
+	conditions.MarkTrue(stackitMachinePool, infrastructurev1alpha1.BootstrapDataAvailableCondition)
+	return bootstrapData.value, nil
+}
+
+// leastUsedAvailabilityZone returns the zone of zones with the fewest
+// servers, the first one of them if several have the same number.
+func leastUsedAvailabilityZone(zones []string, servers []cloud.Server) string {
+	counts := map[string]int{}
+	for _, server := range servers {
+		counts[server.AvailabilityZone]++
+	}
+	return slices.MinFunc(zones, func(a, b string) int {
+		return cmp.Compare(counts[a], counts[b])
+	})
+}
+
+// machinePoolToStackitMachinePool maps a MachinePool to the
+// StackitMachinePool referenced by the infrastructureRef of its template.
+func machinePoolToStackitMachinePool(_ context.Context, obj client.Object) []reconcile.Request {
+	machinePool, ok := obj.(*clusterv1.MachinePool)
+	if !ok {
+		return nil
+	}
+	ref := machinePool.Spec.Template.Spec.InfrastructureRef
+	if !isInfrastructureRef(&ref, "StackitMachinePool") {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Namespace: machinePool.Namespace,
+		Name:      ref.Name,
+	}}}
+}
+
+// clusterToStackitMachinePools maps a Cluster to the StackitMachinePools of
+// its MachinePools.
+func (r *StackitMachinePoolReconciler) clusterToStackitMachinePools(ctx context.Context, obj client.Object) []reconcile.Request {
+	machinePools := &clusterv1.MachinePoolList{}
+	if err := r.List(ctx, machinePools, client.InNamespace(obj.GetNamespace()),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: obj.GetName()}); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list MachinePools", "cluster", obj.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range machinePools.Items {
+		requests = append(requests, machinePoolToStackitMachinePool(ctx, &machinePools.Items[i])...)
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *StackitMachinePoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrastructurev1alpha1.StackitMachinePool{}).
+		Watches(&clusterv1.MachinePool{}, handler.EnqueueRequestsFromMapFunc(machinePoolToStackitMachinePool)).
+		Watches(&clusterv1.Cluster{}, handler.EnqueueRequestsFromMapFunc(r.clusterToStackitMachinePools)).
+		Named("stackitmachinepool").
+		Complete(r)
+}
@@ -0,0 +1,324 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	clusterv1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/capi/v1beta1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud/stackit"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/conditions"
+)
+
+var _ = Describe("StackitMachinePool Controller", func() {
+	Context("When reconciling a resource", func() {
+		const (
+			namespace       = "default"
+			clusterName     = "machinepool-test"
+			machinePoolName = "machinepool-test-mp-0"
+			projectID       = "44444444-4444-4444-4444-444444444444"
+		)
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      machinePoolName,
+			Namespace: namespace,
+		}
+
+		var (
+			reconciler  *StackitMachinePoolReconciler
+			machinePool *clusterv1.MachinePool
+			api         cloud.Interface
+		)
+
+		// poolServers returns the servers of the StackitMachinePool.
+		poolServers := func(g Gomega, resource *infrastructurev1alpha1.StackitMachinePool) []cloud.Server {
+			servers, err := api.Servers().List(ctx, map[string]string{cloud.MachinePoolUIDLabel: string(resource.UID)})
+			g.Expect(err).NotTo(HaveOccurred())
+			return servers
+		}
+
+		BeforeEach(func() {
+			reconciler = &StackitMachinePoolReconciler{
+				Client:       k8sClient,
+				Scheme:       k8sClient.Scheme(),
+				CloudFactory: cloudFactory,
+			}
+
+			By("creating the network and image in the STACKIT API")
+			var err error
+			api, err = cloudFactory(ctx, cloud.Scope{ProjectID: projectID, Region: "eu01"})
+			Expect(err).NotTo(HaveOccurred())
+			images, err := api.Images().List(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			if !slices.ContainsFunc(images, func(image cloud.Image) bool { return image.Name == "ubuntu-22.04" }) {
+				stackitAPI.AddImage(stackit.ImageModel{Name: "ubuntu-22.04"})
+			}
+			network, err := api.Networks().Create(ctx, cloud.CreateNetworkRequest{Name: clusterName, IPv4Prefix: "10.0.0.0/24"})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("creating the cluster")
+			stackitCluster := &infrastructurev1alpha1.StackitCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace},
+				Spec: infrastructurev1alpha1.StackitClusterSpec{
+					ProjectID: projectID,
+					Region:    "eu01",
+					Network:   infrastructurev1alpha1.NetworkSpec{ID: network.ID},
+				},
+			}
+			Expect(k8sClient.Create(ctx, stackitCluster)).To(Succeed())
+			stackitCluster.Status.Network = &infrastructurev1alpha1.NetworkStatus{ID: network.ID}
+			stackitCluster.Status.FailureDomains = infrastructurev1alpha1.FailureDomains{
+				"eu01-1": {},
+				"eu01-2": {},
+			}
+			Expect(k8sClient.Status().Update(ctx, stackitCluster)).To(Succeed())
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace},
+				Spec: clusterv1.ClusterSpec{
+					InfrastructureRef: &corev1.ObjectReference{
+						APIVersion: infrastructurev1alpha1.GroupVersion.String(),
+						Kind:       "StackitCluster",
+						Name:       clusterName,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, cluster)).To(Succeed())
+			cluster.Status.InfrastructureReady = true
+			Expect(k8sClient.Status().Update(ctx, cluster)).To(Succeed())
+
+			By("creating the machine pool")
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: machinePoolName, Namespace: namespace},
+				Data:       map[string][]byte{"value": []byte("#cloud-config\n")},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			machinePool = &clusterv1.MachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      machinePoolName,
+					Namespace: namespace,
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: clusterName},
+				},
+				Spec: clusterv1.MachinePoolSpec{
+					ClusterName:    clusterName,
+					Replicas:       ptr.To[int32](2),
+					FailureDomains: []string{"eu01-1", "eu01-2"},
+					Template: clusterv1.MachineTemplateSpec{Spec: clusterv1.MachineSpec{
+						ClusterName: clusterName,
+						Bootstrap:   clusterv1.Bootstrap{DataSecretName: ptr.To(secret.Name)},
+						InfrastructureRef: corev1.ObjectReference{
+							APIVersion: infrastructurev1alpha1.GroupVersion.String(),
+							Kind:       "StackitMachinePool",
+							Name:       machinePoolName,
+						},
+						Version: ptr.To("v1.31.2"),
+					}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, machinePool)).To(Succeed())
+
+			resource := &infrastructurev1alpha1.StackitMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      machinePoolName,
+					Namespace: namespace,
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "MachinePool",
+						Name:       machinePool.Name,
+						UID:        machinePool.UID,
+					}},
+				},
+				Spec: infrastructurev1alpha1.StackitMachinePoolSpec{
+					Template: infrastructurev1alpha1.StackitMachinePoolTemplate{
+						MachineType: "c1.2",
+						Image:       infrastructurev1alpha1.ImageSpec{Name: ptr.To("ubuntu-22.04")},
+						BootVolume:  infrastructurev1alpha1.BootVolumeSpec{Size: 20},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &infrastructurev1alpha1.StackitMachinePool{}
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			if err == nil {
+				for _, server := range poolServers(Default, resource) {
+					Expect(client.IgnoreNotFound(api.Servers().Delete(ctx, server.ID))).To(Succeed())
+				}
+				controllerutil.RemoveFinalizer(resource, infrastructurev1alpha1.MachinePoolFinalizer)
+				Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, resource))).To(Succeed())
+			} else {
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+			}
+
+			for _, obj := range []client.Object{
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: machinePoolName, Namespace: namespace}},
+				&clusterv1.MachinePool{ObjectMeta: metav1.ObjectMeta{Name: machinePoolName, Namespace: namespace}},
+				&clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace}},
+				&infrastructurev1alpha1.StackitCluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace}},
+			} {
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, obj))).To(Succeed())
+			}
+		})
+
+		It("should map MachinePools and Clusters to the StackitMachinePool", func() {
+			request := reconcile.Request{NamespacedName: typeNamespacedName}
+			Expect(machinePoolToStackitMachinePool(ctx, machinePool)).To(ConsistOf(request))
+
+			cluster := &clusterv1.Cluster{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: clusterName, Namespace: namespace}, cluster)).To(Succeed())
+			Expect(reconciler.clusterToStackitMachinePools(ctx, cluster)).To(ConsistOf(request))
+		})
+
+		It("should scale the servers to the replicas of the MachinePool", func() {
+			By("creating a server in every failure domain")
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			resource := &infrastructurev1alpha1.StackitMachinePool{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Finalizers).To(ContainElement(infrastructurev1alpha1.MachinePoolFinalizer))
+			servers := poolServers(Default, resource)
+			Expect(servers).To(HaveLen(2))
+			Expect(servers).To(ConsistOf(
+				HaveField("AvailabilityZone", "eu01-1"),
+				HaveField("AvailabilityZone", "eu01-2"),
+			))
+			for _, server := range servers {
+				Expect(server.Labels).To(HaveKeyWithValue(cloud.TemplateHashLabel, resource.Status.TemplateHash))
+				Expect(server.Labels).To(HaveKeyWithValue(cloud.RoleLabel, string(infrastructurev1alpha1.SecurityGroupWorker)))
+				Expect(resource.Spec.ProviderIDList).To(ContainElement(cloud.ProviderID(server.ID)))
+			}
+			Expect(resource.Status.Replicas).To(BeEquivalentTo(2))
+			Expect(resource.Status.ImageID).NotTo(BeEmpty())
+			Expect(conditions.IsTrue(resource, infrastructurev1alpha1.BootstrapDataAvailableCondition)).To(BeTrue())
+
+			By("reporting the running servers")
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Ready).To(BeTrue())
+			Expect(resource.Status.ReadyReplicas).To(BeEquivalentTo(2))
+			Expect(conditions.IsTrue(resource, infrastructurev1alpha1.ReadyCondition)).To(BeTrue())
+
+			By("scaling down")
+			machinePool.Spec.Replicas = ptr.To[int32](1)
+			Expect(k8sClient.Update(ctx, machinePool)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+				g.Expect(poolServers(g, resource)).To(HaveLen(1))
+				g.Expect(resource.Spec.ProviderIDList).To(HaveLen(1))
+				g.Expect(conditions.IsTrue(resource, infrastructurev1alpha1.InstancesReadyCondition)).To(BeTrue())
+			}).Should(Succeed())
+
+			By("deleting the resource")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+			}).Should(Succeed())
+			servers, err = api.Servers().List(ctx, map[string]string{cloud.MachinePoolUIDLabel: string(resource.UID)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(servers).To(BeEmpty())
+		})
+
+		It("should replace the servers when the template changes", func() {
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				resource := &infrastructurev1alpha1.StackitMachinePool{}
+				g.Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+				g.Expect(conditions.IsTrue(resource, infrastructurev1alpha1.InstancesReadyCondition)).To(BeTrue())
+			}).Should(Succeed())
+
+			By("changing the machine type")
+			resource := &infrastructurev1alpha1.StackitMachinePool{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			oldHash := resource.Status.TemplateHash
+			resource.Spec.Template.MachineType = "c1.4"
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			By("surging by one server while keeping two servers running")
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+				servers := poolServers(g, resource)
+				running := slices.DeleteFunc(slices.Clone(servers), func(server cloud.Server) bool {
+					return server.Status != cloud.ServerStatusActive
+				})
+				Expect(len(servers)).To(BeNumerically("<=", 3))
+				Expect(len(running)).To(BeNumerically(">=", 2))
+				g.Expect(conditions.IsTrue(resource, infrastructurev1alpha1.InstancesReadyCondition)).To(BeTrue())
+				g.Expect(servers).To(HaveEach(HaveField("MachineType", "c1.4")))
+			}).Should(Succeed())
+			Expect(resource.Status.TemplateHash).NotTo(Equal(oldHash))
+			Expect(resource.Status.UpToDateReplicas).To(BeEquivalentTo(2))
+
+			By("replacing the servers when the Kubernetes version changes")
+			machinePool.Spec.Template.Spec.Version = ptr.To("v1.32.0")
+			Expect(k8sClient.Update(ctx, machinePool)).To(Succeed())
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(poolServers(Default, resource)).To(HaveLen(3))
+			condition := conditions.Get(resource, infrastructurev1alpha1.InstancesReadyCondition)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(infrastructurev1alpha1.RollingUpdateInProgressReason))
+		})
+
+		It("should replace failed servers", func() {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			resource := &infrastructurev1alpha1.StackitMachinePool{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			servers := poolServers(Default, resource)
+			Expect(servers).To(HaveLen(2))
+			stackitAPI.FailServer(servers[0].ID, "no valid host was found")
+
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+				g.Expect(conditions.IsTrue(resource, infrastructurev1alpha1.InstancesReadyCondition)).To(BeTrue())
+			}).Should(Succeed())
+			Expect(resource.Spec.ProviderIDList).To(HaveLen(2))
+			Expect(resource.Spec.ProviderIDList).NotTo(ContainElement(cloud.ProviderID(servers[0].ID)))
+		})
+	})
+})
@@ -0,0 +1,131 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	clusterv1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/capi/v1beta1"
+)
+
+// StackitManagedClusterReconciler reconciles a StackitManagedCluster object
+type StackitManagedClusterReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitmanagedclusters,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitmanagedclusters/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitmanagedcontrolplanes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch
+
+// Reconcile marks a StackitManagedCluster ready and copies the control plane
+// endpoint of the StackitManagedControlPlane of its Cluster. There is no
+// infrastructure to clean up on deletion.
+func (r *StackitManagedClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	managedCluster := &infrastructurev1alpha1.StackitManagedCluster{}
+	if err := r.Get(ctx, req.NamespacedName, managedCluster); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !managedCluster.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	cluster, err := getOwnerCluster(ctx, r.Client, managedCluster.ObjectMeta)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if cluster == nil {
+		log.Info("Waiting for the Cluster controller to set the owner reference")
+		return ctrl.Result{}, nil
+	}
+	log = log.WithValues("cluster", cluster.Name)
+
+	if isPaused(cluster, managedCluster) {
+		log.Info("Reconciliation is paused")
+		return ctrl.Result{}, nil
+	}
+	ctx = logf.IntoContext(ctx, log)
+
+	before := managedCluster.DeepCopy()
+	managedCluster.Status.Ready = true
+	controlPlane, err := getManagedControlPlane(ctx, r.Client, cluster)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if controlPlane != nil {
+		managedCluster.Spec.ControlPlaneEndpoint = controlPlane.Spec.ControlPlaneEndpoint
+	}
+	return ctrl.Result{}, patchObject(ctx, r.Client, before, managedCluster)
+}
+
+// clusterToStackitManagedCluster maps a Cluster to its StackitManagedCluster.
+func clusterToStackitManagedCluster(_ context.Context, obj client.Object) []reconcile.Request {
+	cluster, ok := obj.(*clusterv1.Cluster)
+	if !ok {
+		return nil
+	}
+	ref := cluster.Spec.InfrastructureRef
+	if !isInfrastructureRef(ref, "StackitManagedCluster") {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Namespace: cmp.Or(ref.Namespace, cluster.Namespace),
+		Name:      ref.Name,
+	}}}
+}
+
+// controlPlaneToStackitManagedCluster maps a StackitManagedControlPlane to
+// the StackitManagedCluster of its Cluster.
+func (r *StackitManagedClusterReconciler) controlPlaneToStackitManagedCluster(ctx context.Context, obj client.Object) []reconcile.Request {
+	name, ok := obj.GetLabels()[clusterv1.ClusterNameLabel]
+	if !ok {
+		return nil
+	}
+	cluster, err := getCluster(ctx, r.Client, obj.GetNamespace(), name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			logf.FromContext(ctx).Error(err, "Failed to get Cluster", "cluster", name)
+		}
+		return nil
+	}
+	return clusterToStackitManagedCluster(ctx, cluster)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *StackitManagedClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrastructurev1alpha1.StackitManagedCluster{}).
+		Watches(&clusterv1.Cluster{}, handler.EnqueueRequestsFromMapFunc(clusterToStackitManagedCluster)).
+		Watches(&infrastructurev1alpha1.StackitManagedControlPlane{},
+			handler.EnqueueRequestsFromMapFunc(r.controlPlaneToStackitManagedCluster)).
+		Named("stackitmanagedcluster").
+		Complete(r)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	clusterv1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/capi/v1beta1"
+)
+
+var _ = Describe("StackitManagedCluster Controller", func() {
+	Context("When reconciling a resource", func() {
+		const (
+			namespace   = "default"
+			clusterName = "managed-cluster-test"
+		)
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      clusterName,
+			Namespace: namespace,
+		}
+
+		var reconciler *StackitManagedClusterReconciler
+
+		BeforeEach(func() {
+			reconciler = &StackitManagedClusterReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("creating the cluster")
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace},
+				Spec: clusterv1.ClusterSpec{
+					ControlPlaneRef: &corev1.ObjectReference{
+						APIVersion: infrastructurev1alpha1.GroupVersion.String(),
+						Kind:       "StackitManagedControlPlane",
+						Name:       clusterName,
+					},
+					InfrastructureRef: &corev1.ObjectReference{
+						APIVersion: infrastructurev1alpha1.GroupVersion.String(),
+						Kind:       "StackitManagedCluster",
+						Name:       clusterName,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, cluster)).To(Succeed())
+			owner := []metav1.OwnerReference{{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "Cluster",
+				Name:       cluster.Name,
+				UID:        cluster.UID,
+			}}
+
+			Expect(k8sClient.Create(ctx, &infrastructurev1alpha1.StackitManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            clusterName,
+					Namespace:       namespace,
+					Labels:          map[string]string{clusterv1.ClusterNameLabel: clusterName},
+					OwnerReferences: owner,
+				},
+				Spec: infrastructurev1alpha1.StackitManagedControlPlaneSpec{
+					ProjectID: "77777777-7777-7777-7777-777777777777",
+					Name:      "mc-test",
+					Version:   "v1.31.4",
+					ControlPlaneEndpoint: infrastructurev1alpha1.APIEndpoint{
+						Host: "api.mc-test.ske.example.com",
+						Port: 443,
+					},
+				},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, &infrastructurev1alpha1.StackitManagedCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace, OwnerReferences: owner},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			for _, obj := range []client.Object{
+				&infrastructurev1alpha1.StackitManagedCluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace}},
+				&infrastructurev1alpha1.StackitManagedControlPlane{ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace}},
+				&clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace}},
+			} {
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, obj))).To(Succeed())
+			}
+		})
+
+		It("should map Clusters and StackitManagedControlPlanes to the StackitManagedCluster", func() {
+			request := reconcile.Request{NamespacedName: typeNamespacedName}
+			cluster := &clusterv1.Cluster{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, cluster)).To(Succeed())
+			Expect(clusterToStackitManagedCluster(ctx, cluster)).To(ConsistOf(request))
+
+			controlPlane := &infrastructurev1alpha1.StackitManagedControlPlane{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, controlPlane)).To(Succeed())
+			Expect(reconciler.controlPlaneToStackitManagedCluster(ctx, controlPlane)).To(ConsistOf(request))
+		})
+
+		It("should be ready with the control plane endpoint of the StackitManagedControlPlane", func() {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			resource := &infrastructurev1alpha1.StackitManagedCluster{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Ready).To(BeTrue())
+			Expect(resource.Spec.ControlPlaneEndpoint).To(Equal(infrastructurev1alpha1.APIEndpoint{
+				Host: "api.mc-test.ske.example.com",
+				Port: 443,
+			}))
+		})
+	})
+})
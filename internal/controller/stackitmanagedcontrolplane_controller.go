@@ -0,0 +1,586 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	clusterv1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/capi/v1beta1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/conditions"
+)
+
+const (
+	// managedClusterSyncPeriod is the interval in which healthy SKE
+	// clusters are polled, as SKE changes them during maintenance.
+	managedClusterSyncPeriod = 5 * time.Minute
+
+	// kubeconfigValidity is how long the kubeconfig requested from SKE is
+	// valid.
+	kubeconfigValidity = 24 * time.Hour
+
+	// kubeconfigRenewBefore is how long before it expires the kubeconfig is
+	// renewed.
+	kubeconfigRenewBefore = 8 * time.Hour
+)
+
+// StackitManagedControlPlaneReconciler reconciles a StackitManagedControlPlane object
+type StackitManagedControlPlaneReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// CloudFactory creates the STACKIT clients used to manage the SKE cluster.
+	CloudFactory cloud.Factory
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitmanagedcontrolplanes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitmanagedcontrolplanes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitmanagedcontrolplanes/finalizers,verbs=update
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitmanagedmachinepools,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;machinepools,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch
+
+// Reconcile creates and updates the SKE cluster of a
+// StackitManagedControlPlane with the node pools of the
+// StackitManagedMachinePools of the Cluster, writes its kubeconfig Secret,
+// and deletes the SKE cluster when the StackitManagedControlPlane is
+// deleted.
+func (r *StackitManagedControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := logf.FromContext(ctx)
+
+	controlPlane := &infrastructurev1alpha1.StackitManagedControlPlane{}
+	if err := r.Get(ctx, req.NamespacedName, controlPlane); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	cluster, err := getOwnerCluster(ctx, r.Client, controlPlane.ObjectMeta)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if cluster == nil {
+		log.Info("Waiting for the Cluster controller to set the owner reference")
+		return ctrl.Result{}, nil
+	}
+	log = log.WithValues("cluster", cluster.Name, "skeCluster", controlPlane.Spec.Name)
+
+	if isPaused(cluster, controlPlane) {
+		log.Info("Reconciliation is paused")
+		return ctrl.Result{}, nil
+	}
+	ctx = logf.IntoContext(ctx, log)
+
+	before := controlPlane.DeepCopy()
+	defer func() {
+		conditions.SetSummary(controlPlane,
+			infrastructurev1alpha1.SKEClusterReadyCondition,
+			infrastructurev1alpha1.KubeconfigAvailableCondition,
+		)
+		if err := patchObject(ctx, r.Client, before, controlPlane); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	scope, err := managedCloudScope(ctx, r.Client, controlPlane)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	stackit, err := r.CloudFactory(ctx, scope)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("creating STACKIT client: %w", err)
+	}
+
+	if !controlPlane.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, stackit, controlPlane)
+	}
+	return r.reconcileNormal(ctx, stackit, cluster, controlPlane)
+}
+
+func (r *StackitManagedControlPlaneReconciler) reconcileNormal(
+	ctx context.Context,
+	stackit cloud.Interface,
+	cluster *clusterv1.Cluster,
+	controlPlane *infrastructurev1alpha1.StackitManagedControlPlane,
+) (ctrl.Result, error) {
+	controllerutil.AddFinalizer(controlPlane, infrastructurev1alpha1.ManagedControlPlaneFinalizer)
+	controlPlane.Status.ExternalManagedControlPlane = true
+
+	skeCluster, err := stackit.ManagedClusters().Get(ctx, controlPlane.Spec.Name)
+	if err != nil && !cloud.IsNotFound(err) {
+		conditions.MarkFalse(controlPlane, infrastructurev1alpha1.SKEClusterReadyCondition,
+			infrastructurev1alpha1.SKEClusterReconciliationFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+		return ctrl.Result{}, fmt.Errorf("getting SKE cluster %s: %w", controlPlane.Spec.Name, err)
+	}
+	if err != nil {
+		skeCluster = nil
+	}
+
+	// The node pools are deleted before the control plane when the Cluster
+	// is deleted, which must not be applied to the SKE cluster.
+	if cluster.DeletionTimestamp.IsZero() {
+		skeCluster, err = r.reconcileSKECluster(ctx, stackit, cluster, controlPlane, skeCluster)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if skeCluster == nil {
+		return ctrl.Result{}, nil
+	}
+
+	controlPlane.Status.State = skeCluster.State
+	controlPlane.Status.Version = "v" + skeCluster.KubernetesVersion
+	healthy := skeCluster.State == cloud.ManagedClusterStateHealthy
+	switch skeCluster.State {
+	case cloud.ManagedClusterStateHealthy:
+		controlPlane.Status.Ready = true
+		conditions.MarkTrue(controlPlane, infrastructurev1alpha1.SKEClusterReadyCondition)
+	case cloud.ManagedClusterStateCreating:
+		conditions.MarkFalse(controlPlane, infrastructurev1alpha1.SKEClusterReadyCondition,
+			infrastructurev1alpha1.SKEClusterProvisioningReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+	case cloud.ManagedClusterStateReconciling:
+		conditions.MarkFalse(controlPlane, infrastructurev1alpha1.SKEClusterReadyCondition,
+			infrastructurev1alpha1.SKEClusterUpdatingReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+	default:
+		conditions.MarkFalse(controlPlane, infrastructurev1alpha1.SKEClusterReadyCondition,
+			infrastructurev1alpha1.SKEClusterUnhealthyReason, infrastructurev1alpha1.ConditionSeverityWarning,
+			"SKE cluster is in state %s: %s", skeCluster.State, strings.Join(skeCluster.Errors, "; "))
+	}
+
+	if !controlPlane.Status.Ready {
+		logf.FromContext(ctx).Info("Waiting for the SKE cluster to be healthy", "state", skeCluster.State)
+		conditions.MarkFalse(controlPlane, infrastructurev1alpha1.KubeconfigAvailableCondition,
+			infrastructurev1alpha1.SKEClusterProvisioningReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	renewAt, err := r.reconcileKubeconfig(ctx, stackit, cluster, controlPlane)
+	if err != nil {
+		conditions.MarkFalse(controlPlane, infrastructurev1alpha1.KubeconfigAvailableCondition,
+			infrastructurev1alpha1.KubeconfigReconciliationFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+		return ctrl.Result{}, err
+	}
+	conditions.MarkTrue(controlPlane, infrastructurev1alpha1.KubeconfigAvailableCondition)
+	controlPlane.Status.Initialized = true
+
+	if !healthy {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+	return ctrl.Result{RequeueAfter: max(min(time.Until(renewAt), managedClusterSyncPeriod), time.Second)}, nil
+}
+
+// reconcileSKECluster creates or updates the SKE cluster of controlPlane and
+// returns it. It returns nil while the cluster does not exist and has no
+// node pools to be created with.
+func (r *StackitManagedControlPlaneReconciler) reconcileSKECluster(
+	ctx context.Context,
+	stackit cloud.Interface,
+	cluster *clusterv1.Cluster,
+	controlPlane *infrastructurev1alpha1.StackitManagedControlPlane,
+	skeCluster *cloud.ManagedCluster,
+) (*cloud.ManagedCluster, error) {
+	log := logf.FromContext(ctx)
+
+	nodePools, err := r.nodePools(ctx, stackit, cluster)
+	if err != nil {
+		conditions.MarkFalse(controlPlane, infrastructurev1alpha1.SKEClusterReadyCondition,
+			infrastructurev1alpha1.SKEClusterReconciliationFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+		return nil, err
+	}
+	if len(nodePools) == 0 {
+		if skeCluster == nil {
+			log.Info("Waiting for a StackitManagedMachinePool to create the SKE cluster with")
+			conditions.MarkFalse(controlPlane, infrastructurev1alpha1.SKEClusterReadyCondition,
+				infrastructurev1alpha1.WaitingForNodePoolsReason, infrastructurev1alpha1.ConditionSeverityInfo,
+				"SKE clusters need at least one node pool")
+			return nil, nil
+		}
+		// The last node pool cannot be removed; it is kept until another
+		// node pool is added.
+		log.Info("Keeping the node pools of the SKE cluster, which needs at least one")
+		return skeCluster, nil
+	}
+
+	desired := desiredManagedCluster(controlPlane, nodePools, skeCluster)
+	if skeCluster != nil && managedClusterUpToDate(skeCluster, desired) {
+		return skeCluster, nil
+	}
+	if skeCluster == nil {
+		log.Info("Creating SKE cluster", "version", desired.KubernetesVersion)
+	} else {
+		log.Info("Updating SKE cluster", "version", desired.KubernetesVersion)
+	}
+	skeCluster, err = stackit.ManagedClusters().CreateOrUpdate(ctx, *desired)
+	if err != nil {
+		conditions.MarkFalse(controlPlane, infrastructurev1alpha1.SKEClusterReadyCondition,
+			infrastructurev1alpha1.SKEClusterReconciliationFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+		return nil, fmt.Errorf("creating or updating SKE cluster %s: %w", desired.Name, err)
+	}
+	return skeCluster, nil
+}
+
+// nodePools returns the node pools of the StackitManagedMachinePools of the
+// MachinePools of cluster, sorted by name. MachinePools and
+// StackitManagedMachinePools being deleted are left out, so that their node
+// pools are removed.
+func (r *StackitManagedControlPlaneReconciler) nodePools(ctx context.Context, stackit cloud.Interface, cluster *clusterv1.Cluster) ([]cloud.NodePool, error) {
+	machinePools := &clusterv1.MachinePoolList{}
+	if err := r.List(ctx, machinePools, client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name}); err != nil {
+		return nil, fmt.Errorf("listing MachinePools: %w", err)
+	}
+
+	var zones []string
+	var nodePools []cloud.NodePool
+	for i := range machinePools.Items {
+		machinePool := &machinePools.Items[i]
+		ref := machinePool.Spec.Template.Spec.InfrastructureRef
+		if !machinePool.DeletionTimestamp.IsZero() || !isInfrastructureRef(&ref, "StackitManagedMachinePool") {
+			continue
+		}
+		pool := &infrastructurev1alpha1.StackitManagedMachinePool{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: machinePool.Namespace, Name: ref.Name}, pool); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("getting StackitManagedMachinePool %s: %w", ref.Name, err)
+		}
+		if !pool.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		nodePool := desiredNodePool(machinePool, pool)
+		if len(nodePool.AvailabilityZones) == 0 {
+			if zones == nil {
+				var err error
+				if zones, err = stackit.AvailabilityZones().List(ctx); err != nil {
+					return nil, fmt.Errorf("listing availability zones: %w", err)
+				}
+			}
+			nodePool.AvailabilityZones = zones
+		}
+		nodePools = append(nodePools, nodePool)
+	}
+	slices.SortFunc(nodePools, func(a, b cloud.NodePool) int { return cmp.Compare(a.Name, b.Name) })
+	return nodePools, nil
+}
+
+// nodePoolName returns the name of the node pool of pool in the SKE cluster.
+func nodePoolName(pool *infrastructurev1alpha1.StackitManagedMachinePool) string {
+	return cmp.Or(pool.Spec.Name, pool.Name)
+}
+
+// desiredNodePool returns the node pool described by pool. Without scaling,
+// the node pool has exactly the replicas of machinePool.
+func desiredNodePool(machinePool *clusterv1.MachinePool, pool *infrastructurev1alpha1.StackitManagedMachinePool) cloud.NodePool {
+	nodePool := cloud.NodePool{
+		Name:              nodePoolName(pool),
+		MachineType:       pool.Spec.MachineType,
+		ImageName:         cmp.Or(pool.Spec.Image.Name, infrastructurev1alpha1.DefaultManagedMachineImage),
+		ImageVersion:      pool.Spec.Image.Version,
+		MaxSurge:          ptr.Deref(pool.Spec.MaxSurge, infrastructurev1alpha1.DefaultMaxSurge),
+		MaxUnavailable:    ptr.Deref(pool.Spec.MaxUnavailable, 0),
+		VolumeType:        pool.Spec.BootVolume.PerformanceClass,
+		VolumeSize:        cmp.Or(pool.Spec.BootVolume.Size, infrastructurev1alpha1.DefaultBootVolumeSize),
+		AvailabilityZones: pool.Spec.AvailabilityZones,
+		Labels:            pool.Spec.Labels,
+	}
+	if len(nodePool.AvailabilityZones) == 0 {
+		nodePool.AvailabilityZones = machinePool.Spec.FailureDomains
+	}
+	if scaling := pool.Spec.Scaling; scaling != nil {
+		nodePool.Minimum, nodePool.Maximum = scaling.MinSize, scaling.MaxSize
+	} else {
+		replicas := ptr.Deref(machinePool.Spec.Replicas, 1)
+		nodePool.Minimum, nodePool.Maximum = replicas, replicas
+	}
+	for _, taint := range pool.Spec.Taints {
+		nodePool.Taints = append(nodePool.Taints, cloud.Taint{Key: taint.Key, Value: taint.Value, Effect: string(taint.Effect)})
+	}
+	return nodePool
+}
+
+// desiredManagedCluster returns the SKE cluster described by controlPlane
+// with the given node pools. Versions of current, the existing SKE cluster,
+// which are newer than the desired ones are kept, as they have been
+// updated by SKE during maintenance.
+func desiredManagedCluster(
+	controlPlane *infrastructurev1alpha1.StackitManagedControlPlane,
+	nodePools []cloud.NodePool,
+	current *cloud.ManagedCluster,
+) *cloud.ManagedCluster {
+	desired := &cloud.ManagedCluster{
+		Name:              controlPlane.Spec.Name,
+		KubernetesVersion: strings.TrimPrefix(controlPlane.Spec.Version, "v"),
+		NodePools:         nodePools,
+		NetworkID:         controlPlane.Spec.NetworkID,
+	}
+	if m := controlPlane.Spec.Maintenance; m != nil {
+		desired.Maintenance = &cloud.Maintenance{
+			AutoUpdateKubernetesVersion:   m.AutoUpdateKubernetesVersion,
+			AutoUpdateMachineImageVersion: m.AutoUpdateMachineImageVersion,
+			Start:                         m.Start,
+			End:                           m.End,
+		}
+	}
+	if current == nil {
+		return desired
+	}
+
+	desired.KubernetesVersion = newerVersion(desired.KubernetesVersion, current.KubernetesVersion)
+	autoUpdateImages := current.Maintenance != nil && current.Maintenance.AutoUpdateMachineImageVersion
+	for i := range desired.NodePools {
+		nodePool := &desired.NodePools[i]
+		existing := findNodePool(current, nodePool.Name)
+		if existing == nil {
+			continue
+		}
+		if autoUpdateImages && existing.ImageName == nodePool.ImageName {
+			nodePool.ImageVersion = newerVersion(nodePool.ImageVersion, existing.ImageVersion)
+		}
+		// SKE defaults the volume type.
+		if nodePool.VolumeType == "" {
+			nodePool.VolumeType = existing.VolumeType
+		}
+	}
+	// SKE defaults the maintenance window.
+	if desired.Maintenance == nil {
+		desired.Maintenance = current.Maintenance
+	}
+	return desired
+}
+
+// newerVersion returns the newer of the versions a and b, or a if they
+// cannot be compared.
+func newerVersion(a, b string) string {
+	va, errA := version.ParseGeneric(a)
+	vb, errB := version.ParseGeneric(b)
+	if errA != nil || errB != nil || !va.LessThan(vb) {
+		return a
+	}
+	return b
+}
+
+// findNodePool returns the node pool of skeCluster with the given name, or nil.
+func findNodePool(skeCluster *cloud.ManagedCluster, name string) *cloud.NodePool {
+	for i := range skeCluster.NodePools {
+		if skeCluster.NodePools[i].Name == name {
+			return &skeCluster.NodePools[i]
+		}
+	}
+	return nil
+}
+
+// managedClusterUpToDate returns true if current, the existing SKE cluster,
+// matches desired.
+func managedClusterUpToDate(current, desired *cloud.ManagedCluster) bool {
+	have := *current
+	have.State, have.Errors = "", nil
+	have.NodePools = slices.SortedFunc(slices.Values(current.NodePools), func(a, b cloud.NodePool) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return equality.Semantic.DeepEqual(&have, desired)
+}
+
+// reconcileKubeconfig writes the kubeconfig of the SKE cluster to the
+// "<cluster>-kubeconfig" Secret read by Cluster API, and sets the control
+// plane endpoint. The kubeconfig is requested from SKE again when it is
+// about to expire. It returns the time the kubeconfig is to be renewed.
+func (r *StackitManagedControlPlaneReconciler) reconcileKubeconfig(
+	ctx context.Context,
+	stackit cloud.Interface,
+	cluster *clusterv1.Cluster,
+	controlPlane *infrastructurev1alpha1.StackitManagedControlPlane,
+) (time.Time, error) {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Namespace: cluster.Namespace,
+		Name:      cluster.Name + "-kubeconfig",
+	}}
+	err := r.Get(ctx, client.ObjectKeyFromObject(secret), secret)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return time.Time{}, fmt.Errorf("getting kubeconfig secret: %w", err)
+	}
+	if err == nil {
+		expiresAt, err := time.Parse(time.RFC3339, secret.Annotations[infrastructurev1alpha1.KubeconfigExpiresAtAnnotation])
+		if err == nil && time.Until(expiresAt) > kubeconfigRenewBefore {
+			endpoint, err := kubeconfigEndpoint(secret.Data["value"])
+			if err == nil {
+				controlPlane.Spec.ControlPlaneEndpoint = endpoint
+				return expiresAt.Add(-kubeconfigRenewBefore), nil
+			}
+		}
+	}
+
+	kubeconfig, err := stackit.ManagedClusters().CreateKubeconfig(ctx, controlPlane.Spec.Name, kubeconfigValidity)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("creating kubeconfig: %w", err)
+	}
+	endpoint, err := kubeconfigEndpoint(kubeconfig.Kubeconfig)
+	if err != nil {
+		return time.Time{}, err
+	}
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if secret.CreationTimestamp.IsZero() {
+			secret.Type = clusterv1.ClusterSecretType
+		}
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		secret.Labels[clusterv1.ClusterNameLabel] = cluster.Name
+		if secret.Annotations == nil {
+			secret.Annotations = map[string]string{}
+		}
+		secret.Annotations[infrastructurev1alpha1.KubeconfigExpiresAtAnnotation] = kubeconfig.ExpiresAt.UTC().Format(time.RFC3339)
+		secret.Data = map[string][]byte{"value": kubeconfig.Kubeconfig}
+		return controllerutil.SetControllerReference(controlPlane, secret, r.Scheme)
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("writing kubeconfig secret: %w", err)
+	}
+	logf.FromContext(ctx).Info("Wrote kubeconfig secret", "secret", secret.Name, "operation", result,
+		"expiresAt", kubeconfig.ExpiresAt)
+	controlPlane.Spec.ControlPlaneEndpoint = endpoint
+	return kubeconfig.ExpiresAt.Add(-kubeconfigRenewBefore), nil
+}
+
+// kubeconfigEndpoint returns the API server endpoint of the current context
+// of a kubeconfig.
+func kubeconfigEndpoint(data []byte) (infrastructurev1alpha1.APIEndpoint, error) {
+	config, err := clientcmd.Load(data)
+	if err != nil {
+		return infrastructurev1alpha1.APIEndpoint{}, fmt.Errorf("parsing kubeconfig: %w", err)
+	}
+	kubeContext, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return infrastructurev1alpha1.APIEndpoint{}, fmt.Errorf("kubeconfig has no context %q", config.CurrentContext)
+	}
+	cluster, ok := config.Clusters[kubeContext.Cluster]
+	if !ok {
+		return infrastructurev1alpha1.APIEndpoint{}, fmt.Errorf("kubeconfig has no cluster %q", kubeContext.Cluster)
+	}
+	server, err := url.Parse(cluster.Server)
+	if err != nil || server.Hostname() == "" {
+		return infrastructurev1alpha1.APIEndpoint{}, fmt.Errorf("kubeconfig has invalid server %q", cluster.Server)
+	}
+	port := 443
+	if server.Port() != "" {
+		if port, err = strconv.Atoi(server.Port()); err != nil {
+			return infrastructurev1alpha1.APIEndpoint{}, fmt.Errorf("kubeconfig has invalid server %q", cluster.Server)
+		}
+	}
+	return infrastructurev1alpha1.APIEndpoint{Host: server.Hostname(), Port: int32(port)}, nil
+}
+
+func (r *StackitManagedControlPlaneReconciler) reconcileDelete(
+	ctx context.Context,
+	stackit cloud.Interface,
+	controlPlane *infrastructurev1alpha1.StackitManagedControlPlane,
+) (ctrl.Result, error) {
+	conditions.MarkFalse(controlPlane, infrastructurev1alpha1.SKEClusterReadyCondition,
+		infrastructurev1alpha1.DeletingReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+	conditions.MarkFalse(controlPlane, infrastructurev1alpha1.KubeconfigAvailableCondition,
+		infrastructurev1alpha1.DeletingReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+	controlPlane.Status.Ready = false
+
+	skeCluster, err := stackit.ManagedClusters().Get(ctx, controlPlane.Spec.Name)
+	if cloud.IsNotFound(err) {
+		controlPlane.Status.State = ""
+		controllerutil.RemoveFinalizer(controlPlane, infrastructurev1alpha1.ManagedControlPlaneFinalizer)
+		return ctrl.Result{}, nil
+	}
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("getting SKE cluster %s: %w", controlPlane.Spec.Name, err)
+	}
+	controlPlane.Status.State = skeCluster.State
+	if skeCluster.State != cloud.ManagedClusterStateDeleting {
+		logf.FromContext(ctx).Info("Deleting SKE cluster")
+		if err := stackit.ManagedClusters().Delete(ctx, controlPlane.Spec.Name); err != nil && !cloud.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("deleting SKE cluster %s: %w", controlPlane.Spec.Name, err)
+		}
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// clusterToStackitManagedControlPlane maps a Cluster to its
+// StackitManagedControlPlane.
+func clusterToStackitManagedControlPlane(_ context.Context, obj client.Object) []reconcile.Request {
+	cluster, ok := obj.(*clusterv1.Cluster)
+	if !ok {
+		return nil
+	}
+	ref := cluster.Spec.ControlPlaneRef
+	if !isInfrastructureRef(ref, "StackitManagedControlPlane") {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Namespace: cmp.Or(ref.Namespace, cluster.Namespace),
+		Name:      ref.Name,
+	}}}
+}
+
+// clusterNameToStackitManagedControlPlane maps an object labeled with the
+// name of a Cluster, for example a MachinePool, to the
+// StackitManagedControlPlane of the Cluster.
+func (r *StackitManagedControlPlaneReconciler) clusterNameToStackitManagedControlPlane(ctx context.Context, obj client.Object) []reconcile.Request {
+	name, ok := obj.GetLabels()[clusterv1.ClusterNameLabel]
+	if !ok {
+		return nil
+	}
+	cluster, err := getCluster(ctx, r.Client, obj.GetNamespace(), name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			logf.FromContext(ctx).Error(err, "Failed to get Cluster", "cluster", name)
+		}
+		return nil
+	}
+	return clusterToStackitManagedControlPlane(ctx, cluster)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *StackitManagedControlPlaneReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrastructurev1alpha1.StackitManagedControlPlane{}).
+		Watches(&clusterv1.Cluster{}, handler.EnqueueRequestsFromMapFunc(clusterToStackitManagedControlPlane)).
+		Watches(&clusterv1.MachinePool{}, handler.EnqueueRequestsFromMapFunc(r.clusterNameToStackitManagedControlPlane)).
+		Watches(&infrastructurev1alpha1.StackitManagedMachinePool{},
+			handler.EnqueueRequestsFromMapFunc(r.clusterNameToStackitManagedControlPlane)).
+		Named("stackitmanagedcontrolplane").
+		Complete(r)
+}
@@ -0,0 +1,338 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	clusterv1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/capi/v1beta1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/conditions"
+)
+
+var _ = Describe("StackitManagedControlPlane Controller", func() {
+	Context("When reconciling a resource", func() {
+		const (
+			namespace       = "default"
+			clusterName     = "managed-test"
+			machinePoolName = "mcp-test-mp-0"
+			skeClusterName  = "mcp-test"
+			projectID       = "55555555-5555-5555-5555-555555555555"
+		)
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      clusterName,
+			Namespace: namespace,
+		}
+
+		var (
+			reconciler  *StackitManagedControlPlaneReconciler
+			machinePool *clusterv1.MachinePool
+			api         cloud.Interface
+		)
+
+		// skeCluster returns the SKE cluster of the StackitManagedControlPlane.
+		skeCluster := func(g Gomega) *cloud.ManagedCluster {
+			skeCluster, err := api.ManagedClusters().Get(ctx, skeClusterName)
+			g.Expect(err).NotTo(HaveOccurred())
+			return skeCluster
+		}
+
+		// createMachinePool creates a MachinePool of the Cluster with a
+		// StackitManagedMachinePool.
+		createMachinePool := func(name string) *clusterv1.MachinePool {
+			machinePool := &clusterv1.MachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: clusterName},
+				},
+				Spec: clusterv1.MachinePoolSpec{
+					ClusterName:    clusterName,
+					Replicas:       ptr.To[int32](2),
+					FailureDomains: []string{"eu01-1", "eu01-2"},
+					Template: clusterv1.MachineTemplateSpec{Spec: clusterv1.MachineSpec{
+						ClusterName: clusterName,
+						InfrastructureRef: corev1.ObjectReference{
+							APIVersion: infrastructurev1alpha1.GroupVersion.String(),
+							Kind:       "StackitManagedMachinePool",
+							Name:       name,
+						},
+					}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, machinePool)).To(Succeed())
+
+			pool := &infrastructurev1alpha1.StackitManagedMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: clusterName},
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "MachinePool",
+						Name:       machinePool.Name,
+						UID:        machinePool.UID,
+					}},
+				},
+				Spec: infrastructurev1alpha1.StackitManagedMachinePoolSpec{
+					MachineType: "c1.2",
+					Image:       infrastructurev1alpha1.ManagedMachineImage{Name: "flatcar", Version: "3975.2.0"},
+				},
+			}
+			Expect(k8sClient.Create(ctx, pool)).To(Succeed())
+			return machinePool
+		}
+
+		BeforeEach(func() {
+			reconciler = &StackitManagedControlPlaneReconciler{
+				Client:       k8sClient,
+				Scheme:       k8sClient.Scheme(),
+				CloudFactory: cloudFactory,
+			}
+
+			var err error
+			api, err = cloudFactory(ctx, cloud.Scope{ProjectID: projectID, Region: "eu01"})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("creating the cluster")
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace},
+				Spec: clusterv1.ClusterSpec{
+					ControlPlaneRef: &corev1.ObjectReference{
+						APIVersion: infrastructurev1alpha1.GroupVersion.String(),
+						Kind:       "StackitManagedControlPlane",
+						Name:       clusterName,
+					},
+					InfrastructureRef: &corev1.ObjectReference{
+						APIVersion: infrastructurev1alpha1.GroupVersion.String(),
+						Kind:       "StackitManagedCluster",
+						Name:       clusterName,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, cluster)).To(Succeed())
+
+			resource := &infrastructurev1alpha1.StackitManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      clusterName,
+					Namespace: namespace,
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: clusterName},
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Cluster",
+						Name:       cluster.Name,
+						UID:        cluster.UID,
+					}},
+				},
+				Spec: infrastructurev1alpha1.StackitManagedControlPlaneSpec{
+					ProjectID: projectID,
+					Region:    "eu01",
+					Name:      skeClusterName,
+					Version:   "v1.31.4",
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+
+			By("creating the machine pool")
+			machinePool = createMachinePool(machinePoolName)
+		})
+
+		AfterEach(func() {
+			if err := api.ManagedClusters().Delete(ctx, skeClusterName); !cloud.IsNotFound(err) {
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			resource := &infrastructurev1alpha1.StackitManagedControlPlane{}
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			if err == nil {
+				controllerutil.RemoveFinalizer(resource, infrastructurev1alpha1.ManagedControlPlaneFinalizer)
+				Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, resource))).To(Succeed())
+			} else {
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+			}
+
+			for _, obj := range []client.Object{
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: clusterName + "-kubeconfig", Namespace: namespace}},
+				&infrastructurev1alpha1.StackitManagedMachinePool{ObjectMeta: metav1.ObjectMeta{Name: machinePoolName, Namespace: namespace}},
+				&infrastructurev1alpha1.StackitManagedMachinePool{ObjectMeta: metav1.ObjectMeta{Name: machinePoolName + "-1", Namespace: namespace}},
+				&clusterv1.MachinePool{ObjectMeta: metav1.ObjectMeta{Name: machinePoolName, Namespace: namespace}},
+				&clusterv1.MachinePool{ObjectMeta: metav1.ObjectMeta{Name: machinePoolName + "-1", Namespace: namespace}},
+				&clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace}},
+			} {
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, obj))).To(Succeed())
+			}
+		})
+
+		It("should map Clusters, MachinePools and StackitManagedMachinePools to the StackitManagedControlPlane", func() {
+			request := reconcile.Request{NamespacedName: typeNamespacedName}
+			cluster := &clusterv1.Cluster{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, cluster)).To(Succeed())
+			Expect(clusterToStackitManagedControlPlane(ctx, cluster)).To(ConsistOf(request))
+			Expect(reconciler.clusterNameToStackitManagedControlPlane(ctx, machinePool)).To(ConsistOf(request))
+
+			pool := &infrastructurev1alpha1.StackitManagedMachinePool{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: machinePoolName, Namespace: namespace}, pool)).To(Succeed())
+			Expect(reconciler.clusterNameToStackitManagedControlPlane(ctx, pool)).To(ConsistOf(request))
+		})
+
+		It("should create the SKE cluster and write the kubeconfig secret", func() {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("creating the SKE cluster with the node pools of the MachinePools")
+			created := skeCluster(Default)
+			Expect(created.KubernetesVersion).To(Equal("1.31.4"))
+			Expect(created.NodePools).To(HaveLen(1))
+			nodePool := created.NodePools[0]
+			Expect(nodePool.Name).To(Equal(machinePoolName))
+			Expect(nodePool.MachineType).To(Equal("c1.2"))
+			Expect(nodePool.ImageName).To(Equal("flatcar"))
+			Expect(nodePool.ImageVersion).To(Equal("3975.2.0"))
+			Expect(nodePool.Minimum).To(BeEquivalentTo(2))
+			Expect(nodePool.Maximum).To(BeEquivalentTo(2))
+			Expect(nodePool.AvailabilityZones).To(Equal([]string{"eu01-1", "eu01-2"}))
+
+			resource := &infrastructurev1alpha1.StackitManagedControlPlane{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Finalizers).To(ContainElement(infrastructurev1alpha1.ManagedControlPlaneFinalizer))
+			Expect(resource.Status.Ready).To(BeTrue())
+			Expect(resource.Status.Initialized).To(BeTrue())
+			Expect(resource.Status.ExternalManagedControlPlane).To(BeTrue())
+			Expect(resource.Status.Version).To(Equal("v1.31.4"))
+			Expect(resource.Status.State).To(Equal(cloud.ManagedClusterStateHealthy))
+			Expect(conditions.IsTrue(resource, infrastructurev1alpha1.ReadyCondition)).To(BeTrue())
+			Expect(resource.Spec.ControlPlaneEndpoint).To(Equal(infrastructurev1alpha1.APIEndpoint{
+				Host: "api." + skeClusterName + "." + projectID + ".ske.example.com",
+				Port: 443,
+			}))
+
+			By("writing the kubeconfig secret in the Cluster API format")
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: clusterName + "-kubeconfig", Namespace: namespace}, secret)).
+				To(Succeed())
+			Expect(secret.Type).To(Equal(clusterv1.ClusterSecretType))
+			Expect(secret.Labels).To(HaveKeyWithValue(clusterv1.ClusterNameLabel, clusterName))
+			Expect(secret.Data).To(HaveKey("value"))
+			Expect(metav1.IsControlledBy(secret, resource)).To(BeTrue())
+			expiresAt, err := time.Parse(time.RFC3339, secret.Annotations[infrastructurev1alpha1.KubeconfigExpiresAtAnnotation])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(time.Until(expiresAt)).To(BeNumerically("~", kubeconfigValidity, time.Minute))
+
+			By("not updating the SKE cluster or the secret when nothing changed")
+			requests := len(stackitAPI.Requests())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stackitAPI.Requests()[requests:]).NotTo(ContainElement(HavePrefix("PUT ")))
+			Expect(stackitAPI.Requests()[requests:]).NotTo(ContainElement(HaveSuffix("/kubeconfig")))
+
+			By("renewing the kubeconfig before it expires")
+			secret.Annotations[infrastructurev1alpha1.KubeconfigExpiresAtAnnotation] =
+				time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+			Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+			expiresAt, err = time.Parse(time.RFC3339, secret.Annotations[infrastructurev1alpha1.KubeconfigExpiresAtAnnotation])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(time.Until(expiresAt)).To(BeNumerically(">", kubeconfigRenewBefore))
+		})
+
+		It("should wait for a node pool before creating the SKE cluster", func() {
+			Expect(k8sClient.Delete(ctx, machinePool)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = api.ManagedClusters().Get(ctx, skeClusterName)
+			Expect(cloud.IsNotFound(err)).To(BeTrue())
+
+			resource := &infrastructurev1alpha1.StackitManagedControlPlane{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Ready).To(BeFalse())
+			condition := conditions.Get(resource, infrastructurev1alpha1.SKEClusterReadyCondition)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(infrastructurev1alpha1.WaitingForNodePoolsReason))
+		})
+
+		It("should update the node pools and keep versions updated by SKE", func() {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("updating the Kubernetes version as SKE does during maintenance")
+			updated := skeCluster(Default)
+			updated.KubernetesVersion = "1.31.5"
+			_, err = api.ManagedClusters().CreateOrUpdate(ctx, *updated)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("adding a MachinePool and scaling the existing one")
+			createMachinePool(machinePoolName + "-1")
+			machinePool.Spec.Replicas = ptr.To[int32](3)
+			Expect(k8sClient.Update(ctx, machinePool)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			updated = skeCluster(Default)
+			Expect(updated.KubernetesVersion).To(Equal("1.31.5"))
+			Expect(updated.NodePools).To(ConsistOf(
+				And(HaveField("Name", machinePoolName), HaveField("Maximum", BeEquivalentTo(3))),
+				And(HaveField("Name", machinePoolName+"-1"), HaveField("Maximum", BeEquivalentTo(2))),
+			))
+
+			resource := &infrastructurev1alpha1.StackitManagedControlPlane{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Version).To(Equal("v1.31.5"))
+
+			By("removing the node pool of a deleted MachinePool")
+			Expect(k8sClient.Delete(ctx, machinePool)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(skeCluster(Default).NodePools).To(ConsistOf(HaveField("Name", machinePoolName+"-1")))
+		})
+
+		It("should delete the SKE cluster", func() {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			resource := &infrastructurev1alpha1.StackitManagedControlPlane{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Eventually(func(g Gomega) {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+			}).Should(Succeed())
+			_, err = api.ManagedClusters().Get(ctx, skeClusterName)
+			Expect(cloud.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,319 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	clusterv1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/capi/v1beta1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/conditions"
+)
+
+// StackitManagedMachinePoolReconciler reconciles a StackitManagedMachinePool object
+type StackitManagedMachinePoolReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// CloudFactory creates the STACKIT clients used to look up the SKE cluster.
+	CloudFactory cloud.Factory
+
+	// RemoteClient returns a client of the workload cluster the nodes of the
+	// pool are listed with. Defaults to a client using the kubeconfig
+	// Secret of the cluster.
+	RemoteClient RemoteClientFunc
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitmanagedmachinepools,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitmanagedmachinepools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitmanagedmachinepools/finalizers,verbs=update
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=stackitmanagedcontrolplanes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools,verbs=get;list;watch
+
+// Reconcile reports the nodes of the node pool of a
+// StackitManagedMachinePool. The node pool itself is created, updated and
+// deleted by the StackitManagedControlPlane reconciler; a
+// StackitManagedMachinePool being deleted is kept until its node pool has
+// been removed from the SKE cluster.
+func (r *StackitManagedMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := logf.FromContext(ctx)
+
+	pool := &infrastructurev1alpha1.StackitManagedMachinePool{}
+	if err := r.Get(ctx, req.NamespacedName, pool); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	machinePool, err := getOwnerMachinePool(ctx, r.Client, pool.ObjectMeta)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if machinePool == nil {
+		log.Info("Waiting for the MachinePool controller to set the owner reference")
+		return ctrl.Result{}, nil
+	}
+	log = log.WithValues("machinePool", machinePool.Name)
+
+	cluster, err := getCluster(ctx, r.Client, machinePool.Namespace, machinePool.Spec.ClusterName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	log = log.WithValues("cluster", cluster.Name, "nodePool", nodePoolName(pool))
+
+	if isPaused(cluster, pool) {
+		log.Info("Reconciliation is paused")
+		return ctrl.Result{}, nil
+	}
+	ctx = logf.IntoContext(ctx, log)
+
+	before := pool.DeepCopy()
+	defer func() {
+		conditions.SetSummary(pool, infrastructurev1alpha1.NodePoolReadyCondition)
+		if err := patchObject(ctx, r.Client, before, pool); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	controlPlane, err := getManagedControlPlane(ctx, r.Client, cluster)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !pool.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, cluster, controlPlane, pool)
+	}
+	if controlPlane == nil {
+		log.Info("Waiting for the Cluster controlPlaneRef to reference a StackitManagedControlPlane")
+		conditions.MarkFalse(pool, infrastructurev1alpha1.NodePoolReadyCondition,
+			infrastructurev1alpha1.WaitingForControlPlaneReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+		return ctrl.Result{}, nil
+	}
+	return r.reconcileNormal(ctx, cluster, controlPlane, pool)
+}
+
+func (r *StackitManagedMachinePoolReconciler) reconcileNormal(
+	ctx context.Context,
+	cluster *clusterv1.Cluster,
+	controlPlane *infrastructurev1alpha1.StackitManagedControlPlane,
+	pool *infrastructurev1alpha1.StackitManagedMachinePool,
+) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	controllerutil.AddFinalizer(pool, infrastructurev1alpha1.ManagedMachinePoolFinalizer)
+
+	skeCluster, err := r.getSKECluster(ctx, controlPlane)
+	if err != nil {
+		conditions.MarkFalse(pool, infrastructurev1alpha1.NodePoolReadyCondition,
+			infrastructurev1alpha1.NodePoolReconciliationFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+		return ctrl.Result{}, err
+	}
+	if skeCluster == nil {
+		log.Info("Waiting for the SKE cluster to be created")
+		conditions.MarkFalse(pool, infrastructurev1alpha1.NodePoolReadyCondition,
+			infrastructurev1alpha1.WaitingForControlPlaneReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+	nodePool := findNodePool(skeCluster, nodePoolName(pool))
+	if nodePool == nil {
+		log.Info("Waiting for the node pool to be added to the SKE cluster")
+		conditions.MarkFalse(pool, infrastructurev1alpha1.NodePoolReadyCondition,
+			infrastructurev1alpha1.NodePoolProvisioningReason, infrastructurev1alpha1.ConditionSeverityInfo,
+			"Node pool has not been added to the SKE cluster yet")
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+	if !controlPlane.Status.Initialized {
+		log.Info("Waiting for the kubeconfig of the SKE cluster")
+		conditions.MarkFalse(pool, infrastructurev1alpha1.NodePoolReadyCondition,
+			infrastructurev1alpha1.NodePoolProvisioningReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	remoteClient := r.RemoteClient
+	if remoteClient == nil {
+		remoteClient = newRemoteClient
+	}
+	remote, err := remoteClient(ctx, r.Client, client.ObjectKeyFromObject(cluster))
+	if err != nil {
+		conditions.MarkFalse(pool, infrastructurev1alpha1.NodePoolReadyCondition,
+			infrastructurev1alpha1.NodePoolReconciliationFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+		return ctrl.Result{}, err
+	}
+	nodes := &corev1.NodeList{}
+	if err := remote.List(ctx, nodes, client.MatchingLabels{infrastructurev1alpha1.NodePoolLabel: nodePool.Name}); err != nil {
+		conditions.MarkFalse(pool, infrastructurev1alpha1.NodePoolReadyCondition,
+			infrastructurev1alpha1.NodePoolReconciliationFailedReason, infrastructurev1alpha1.ConditionSeverityWarning, "%s", err)
+		return ctrl.Result{}, fmt.Errorf("listing nodes: %w", err)
+	}
+
+	var providerIDs []string
+	var ready int32
+	for _, node := range nodes.Items {
+		if node.Spec.ProviderID == "" {
+			continue
+		}
+		providerIDs = append(providerIDs, node.Spec.ProviderID)
+		if isNodeReady(&node) {
+			ready++
+		}
+	}
+	slices.Sort(providerIDs)
+	pool.Spec.ProviderIDList = providerIDs
+	pool.Status.Replicas = int32(len(providerIDs))
+
+	if skeCluster.State == cloud.ManagedClusterStateHealthy && ready >= nodePool.Minimum {
+		pool.Status.Ready = true
+		conditions.MarkTrue(pool, infrastructurev1alpha1.NodePoolReadyCondition)
+		return ctrl.Result{RequeueAfter: managedClusterSyncPeriod}, nil
+	}
+	log.Info("Waiting for the nodes of the node pool", "state", skeCluster.State, "readyNodes", ready,
+		"minimum", nodePool.Minimum)
+	conditions.MarkFalse(pool, infrastructurev1alpha1.NodePoolReadyCondition,
+		infrastructurev1alpha1.NodePoolProvisioningReason, infrastructurev1alpha1.ConditionSeverityInfo,
+		"%d of %d nodes are ready", ready, nodePool.Minimum)
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *StackitManagedMachinePoolReconciler) reconcileDelete(
+	ctx context.Context,
+	cluster *clusterv1.Cluster,
+	controlPlane *infrastructurev1alpha1.StackitManagedControlPlane,
+	pool *infrastructurev1alpha1.StackitManagedMachinePool,
+) (ctrl.Result, error) {
+	conditions.MarkFalse(pool, infrastructurev1alpha1.NodePoolReadyCondition,
+		infrastructurev1alpha1.DeletingReason, infrastructurev1alpha1.ConditionSeverityInfo, "")
+	pool.Status.Ready = false
+
+	// The node pools of a Cluster being deleted are deleted together with
+	// the SKE cluster.
+	if !cluster.DeletionTimestamp.IsZero() || controlPlane == nil || !controlPlane.DeletionTimestamp.IsZero() {
+		controllerutil.RemoveFinalizer(pool, infrastructurev1alpha1.ManagedMachinePoolFinalizer)
+		return ctrl.Result{}, nil
+	}
+
+	skeCluster, err := r.getSKECluster(ctx, controlPlane)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if skeCluster != nil && findNodePool(skeCluster, nodePoolName(pool)) != nil {
+		logf.FromContext(ctx).Info("Waiting for the node pool to be removed from the SKE cluster")
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+	pool.Spec.ProviderIDList = nil
+	pool.Status.Replicas = 0
+	controllerutil.RemoveFinalizer(pool, infrastructurev1alpha1.ManagedMachinePoolFinalizer)
+	return ctrl.Result{}, nil
+}
+
+// getSKECluster returns the SKE cluster of controlPlane, or nil if it does
+// not exist.
+func (r *StackitManagedMachinePoolReconciler) getSKECluster(
+	ctx context.Context,
+	controlPlane *infrastructurev1alpha1.StackitManagedControlPlane,
+) (*cloud.ManagedCluster, error) {
+	scope, err := managedCloudScope(ctx, r.Client, controlPlane)
+	if err != nil {
+		return nil, err
+	}
+	stackit, err := r.CloudFactory(ctx, scope)
+	if err != nil {
+		return nil, fmt.Errorf("creating STACKIT client: %w", err)
+	}
+	skeCluster, err := stackit.ManagedClusters().Get(ctx, controlPlane.Spec.Name)
+	if cloud.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting SKE cluster %s: %w", controlPlane.Spec.Name, err)
+	}
+	return skeCluster, nil
+}
+
+// isNodeReady returns true if node has the Ready condition.
+func isNodeReady(node *corev1.Node) bool {
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// machinePoolToStackitManagedMachinePool maps a MachinePool to its
+// StackitManagedMachinePool.
+func machinePoolToStackitManagedMachinePool(_ context.Context, obj client.Object) []reconcile.Request {
+	machinePool, ok := obj.(*clusterv1.MachinePool)
+	if !ok {
+		return nil
+	}
+	ref := machinePool.Spec.Template.Spec.InfrastructureRef
+	if !isInfrastructureRef(&ref, "StackitManagedMachinePool") {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Namespace: machinePool.Namespace,
+		Name:      ref.Name,
+	}}}
+}
+
+// clusterNameToStackitManagedMachinePools maps an object labeled with the
+// name of a Cluster, for example the Cluster's StackitManagedControlPlane,
+// to the StackitManagedMachinePools of the MachinePools of the Cluster.
+func (r *StackitManagedMachinePoolReconciler) clusterNameToStackitManagedMachinePools(ctx context.Context, obj client.Object) []reconcile.Request {
+	name := obj.GetLabels()[clusterv1.ClusterNameLabel]
+	if _, ok := obj.(*clusterv1.Cluster); ok {
+		name = obj.GetName()
+	}
+	if name == "" {
+		return nil
+	}
+	machinePools := &clusterv1.MachinePoolList{}
+	if err := r.List(ctx, machinePools, client.InNamespace(obj.GetNamespace()),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: name}); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list MachinePools", "cluster", name)
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range machinePools.Items {
+		requests = append(requests, machinePoolToStackitManagedMachinePool(ctx, &machinePools.Items[i])...)
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *StackitManagedMachinePoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrastructurev1alpha1.StackitManagedMachinePool{}).
+		Watches(&clusterv1.MachinePool{}, handler.EnqueueRequestsFromMapFunc(machinePoolToStackitManagedMachinePool)).
+		Watches(&clusterv1.Cluster{}, handler.EnqueueRequestsFromMapFunc(r.clusterNameToStackitManagedMachinePools)).
+		Watches(&infrastructurev1alpha1.StackitManagedControlPlane{},
+			handler.EnqueueRequestsFromMapFunc(r.clusterNameToStackitManagedMachinePools)).
+		Named("stackitmanagedmachinepool").
+		Complete(r)
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	clusterv1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/capi/v1beta1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/conditions"
+)
+
+var _ = Describe("StackitManagedMachinePool Controller", func() {
+	Context("When reconciling a resource", func() {
+		const (
+			namespace       = "default"
+			clusterName     = "managed-mp-test"
+			machinePoolName = "mmp-test-mp-0"
+			skeClusterName  = "mmp-test"
+			projectID       = "66666666-6666-6666-6666-666666666666"
+		)
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      machinePoolName,
+			Namespace: namespace,
+		}
+		controlPlaneName := types.NamespacedName{
+			Name:      clusterName,
+			Namespace: namespace,
+		}
+
+		var (
+			reconciler             *StackitManagedMachinePoolReconciler
+			controlPlaneReconciler *StackitManagedControlPlaneReconciler
+			machinePool            *clusterv1.MachinePool
+			nodes                  []client.Object
+			api                    cloud.Interface
+		)
+
+		// node returns a node of the node pool.
+		node := func(name string, ready bool) *corev1.Node {
+			status := corev1.ConditionFalse
+			if ready {
+				status = corev1.ConditionTrue
+			}
+			return &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   name,
+					Labels: map[string]string{infrastructurev1alpha1.NodePoolLabel: machinePoolName},
+				},
+				Spec: corev1.NodeSpec{ProviderID: "openstack:///" + name},
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+					{Type: corev1.NodeReady, Status: status},
+				}},
+			}
+		}
+
+		BeforeEach(func() {
+			nodes = nil
+			reconciler = &StackitManagedMachinePoolReconciler{
+				Client:       k8sClient,
+				Scheme:       k8sClient.Scheme(),
+				CloudFactory: cloudFactory,
+				RemoteClient: func(context.Context, client.Client, client.ObjectKey) (client.Client, error) {
+					return fake.NewClientBuilder().WithObjects(nodes...).Build(), nil
+				},
+			}
+			controlPlaneReconciler = &StackitManagedControlPlaneReconciler{
+				Client:       k8sClient,
+				Scheme:       k8sClient.Scheme(),
+				CloudFactory: cloudFactory,
+			}
+
+			var err error
+			api, err = cloudFactory(ctx, cloud.Scope{ProjectID: projectID, Region: "eu01"})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("creating the cluster")
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace},
+				Spec: clusterv1.ClusterSpec{
+					ControlPlaneRef: &corev1.ObjectReference{
+						APIVersion: infrastructurev1alpha1.GroupVersion.String(),
+						Kind:       "StackitManagedControlPlane",
+						Name:       clusterName,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, cluster)).To(Succeed())
+			controlPlane := &infrastructurev1alpha1.StackitManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      clusterName,
+					Namespace: namespace,
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: clusterName},
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Cluster",
+						Name:       cluster.Name,
+						UID:        cluster.UID,
+					}},
+				},
+				Spec: infrastructurev1alpha1.StackitManagedControlPlaneSpec{
+					ProjectID: projectID,
+					Region:    "eu01",
+					Name:      skeClusterName,
+					Version:   "v1.31.4",
+				},
+			}
+			Expect(k8sClient.Create(ctx, controlPlane)).To(Succeed())
+
+			By("creating the machine pool")
+			machinePool = &clusterv1.MachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      machinePoolName,
+					Namespace: namespace,
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: clusterName},
+				},
+				Spec: clusterv1.MachinePoolSpec{
+					ClusterName:    clusterName,
+					Replicas:       ptr.To[int32](2),
+					FailureDomains: []string{"eu01-1"},
+					Template: clusterv1.MachineTemplateSpec{Spec: clusterv1.MachineSpec{
+						ClusterName: clusterName,
+						InfrastructureRef: corev1.ObjectReference{
+							APIVersion: infrastructurev1alpha1.GroupVersion.String(),
+							Kind:       "StackitManagedMachinePool",
+							Name:       machinePoolName,
+						},
+					}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, machinePool)).To(Succeed())
+			resource := &infrastructurev1alpha1.StackitManagedMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      machinePoolName,
+					Namespace: namespace,
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "MachinePool",
+						Name:       machinePool.Name,
+						UID:        machinePool.UID,
+					}},
+				},
+				Spec: infrastructurev1alpha1.StackitManagedMachinePoolSpec{MachineType: "c1.2"},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			if err := api.ManagedClusters().Delete(ctx, skeClusterName); !cloud.IsNotFound(err) {
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			for _, obj := range []client.Object{
+				&infrastructurev1alpha1.StackitManagedMachinePool{ObjectMeta: metav1.ObjectMeta{Name: machinePoolName, Namespace: namespace}},
+				&infrastructurev1alpha1.StackitManagedControlPlane{ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace}},
+			} {
+				err := k8sClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+				if err != nil {
+					Expect(errors.IsNotFound(err)).To(BeTrue())
+					continue
+				}
+				obj.SetFinalizers(nil)
+				Expect(k8sClient.Update(ctx, obj)).To(Succeed())
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, obj))).To(Succeed())
+			}
+
+			for _, obj := range []client.Object{
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: clusterName + "-kubeconfig", Namespace: namespace}},
+				&infrastructurev1alpha1.StackitManagedMachinePool{ObjectMeta: metav1.ObjectMeta{Name: "mmp-test-mp-1", Namespace: namespace}},
+				&clusterv1.MachinePool{ObjectMeta: metav1.ObjectMeta{Name: machinePoolName, Namespace: namespace}},
+				&clusterv1.MachinePool{ObjectMeta: metav1.ObjectMeta{Name: "mmp-test-mp-1", Namespace: namespace}},
+				&clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace}},
+			} {
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, obj))).To(Succeed())
+			}
+		})
+
+		It("should map MachinePools, Clusters and StackitManagedControlPlanes to the StackitManagedMachinePool", func() {
+			request := reconcile.Request{NamespacedName: typeNamespacedName}
+			Expect(machinePoolToStackitManagedMachinePool(ctx, machinePool)).To(ConsistOf(request))
+
+			cluster := &clusterv1.Cluster{}
+			Expect(k8sClient.Get(ctx, controlPlaneName, cluster)).To(Succeed())
+			Expect(reconciler.clusterNameToStackitManagedMachinePools(ctx, cluster)).To(ConsistOf(request))
+
+			controlPlane := &infrastructurev1alpha1.StackitManagedControlPlane{}
+			Expect(k8sClient.Get(ctx, controlPlaneName, controlPlane)).To(Succeed())
+			Expect(reconciler.clusterNameToStackitManagedMachinePools(ctx, controlPlane)).To(ConsistOf(request))
+		})
+
+		It("should report the nodes of the node pool", func() {
+			By("waiting for the SKE cluster")
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			resource := &infrastructurev1alpha1.StackitManagedMachinePool{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Finalizers).To(ContainElement(infrastructurev1alpha1.ManagedMachinePoolFinalizer))
+			Expect(resource.Status.Ready).To(BeFalse())
+			condition := conditions.Get(resource, infrastructurev1alpha1.NodePoolReadyCondition)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(infrastructurev1alpha1.WaitingForControlPlaneReason))
+
+			By("waiting for the nodes to be ready")
+			_, err = controlPlaneReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: controlPlaneName})
+			Expect(err).NotTo(HaveOccurred())
+			nodes = []client.Object{node("node-a", true), node("node-b", false)}
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Ready).To(BeFalse())
+			Expect(resource.Status.Replicas).To(BeEquivalentTo(2))
+			Expect(resource.Spec.ProviderIDList).To(Equal([]string{"openstack:///node-a", "openstack:///node-b"}))
+			condition = conditions.Get(resource, infrastructurev1alpha1.NodePoolReadyCondition)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(infrastructurev1alpha1.NodePoolProvisioningReason))
+			Expect(condition.Message).To(Equal("1 of 2 nodes are ready"))
+
+			By("being ready when the minimum of nodes is ready")
+			nodes = []client.Object{node("node-a", true), node("node-b", true)}
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Ready).To(BeTrue())
+			Expect(conditions.IsTrue(resource, infrastructurev1alpha1.ReadyCondition)).To(BeTrue())
+		})
+
+		It("should keep the StackitManagedMachinePool until its node pool is removed", func() {
+			_, err := controlPlaneReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: controlPlaneName})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("keeping the last node pool of the SKE cluster")
+			resource := &infrastructurev1alpha1.StackitManagedMachinePool{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			_, err = controlPlaneReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: controlPlaneName})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Finalizers).To(ContainElement(infrastructurev1alpha1.ManagedMachinePoolFinalizer))
+
+			By("removing the node pool once another node pool is added")
+			other := &clusterv1.MachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mmp-test-mp-1",
+					Namespace: namespace,
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: clusterName},
+				},
+				Spec: clusterv1.MachinePoolSpec{
+					ClusterName:    clusterName,
+					FailureDomains: []string{"eu01-1"},
+					Template: clusterv1.MachineTemplateSpec{Spec: clusterv1.MachineSpec{
+						ClusterName: clusterName,
+						InfrastructureRef: corev1.ObjectReference{
+							APIVersion: infrastructurev1alpha1.GroupVersion.String(),
+							Kind:       "StackitManagedMachinePool",
+							Name:       "mmp-test-mp-1",
+						},
+					}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, other)).To(Succeed())
+			Expect(k8sClient.Create(ctx, &infrastructurev1alpha1.StackitManagedMachinePool{
+				ObjectMeta: metav1.ObjectMeta{Name: "mmp-test-mp-1", Namespace: namespace},
+				Spec:       infrastructurev1alpha1.StackitManagedMachinePoolSpec{MachineType: "c1.2"},
+			})).To(Succeed())
+			_, err = controlPlaneReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: controlPlaneName})
+			Expect(err).NotTo(HaveOccurred())
+			skeCluster, err := api.ManagedClusters().Get(ctx, skeClusterName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(skeCluster.NodePools).To(ConsistOf(HaveField("Name", "mmp-test-mp-1")))
+
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+		})
+
+		It("should not wait for the node pool when the control plane is deleted", func() {
+			_, err := controlPlaneReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: controlPlaneName})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			controlPlane := &infrastructurev1alpha1.StackitManagedControlPlane{}
+			Expect(k8sClient.Get(ctx, controlPlaneName, controlPlane)).To(Succeed())
+			Expect(controllerutil.ContainsFinalizer(controlPlane, infrastructurev1alpha1.ManagedControlPlaneFinalizer)).To(BeTrue())
+			Expect(k8sClient.Delete(ctx, controlPlane)).To(Succeed())
+
+			resource := &infrastructurev1alpha1.StackitManagedMachinePool{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, resource))).To(BeTrue())
+		})
+	})
+})
@@ -33,6 +33,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	clusterv1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/capi/v1beta1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud/s3"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud/stackit"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/test/s3api"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/test/stackitapi"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -45,8 +51,20 @@ var (
 	testEnv   *envtest.Environment
 	cfg       *rest.Config
 	k8sClient client.Client
+
+	// stackitAPI stands in for the STACKIT APIs, cloudFactory creates
+	// clients talking to it.
+	stackitAPI   *stackitapi.Server
+	cloudFactory cloud.Factory
+
+	// objectStorageAPI stands in for STACKIT Object Storage, accepting
+	// requests signed with objectStorageAccessKeyID.
+	objectStorageAPI     *s3api.Server
+	objectStorageFactory cloud.ObjectStorageFactory
 )
 
+const objectStorageAccessKeyID = "access-key"
+
 func TestControllers(t *testing.T) {
 	RegisterFailHandler(Fail)
 
@@ -62,11 +80,17 @@ var _ = BeforeSuite(func() {
 	err = infrastructurev1alpha1.AddToScheme(scheme.Scheme)
 	Expect(err).NotTo(HaveOccurred())
 
+	err = clusterv1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+
 	// +kubebuilder:scaffold:scheme
 
 	By("bootstrapping test environment")
 	testEnv = &envtest.Environment{
-		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "config", "crd", "bases")},
+		CRDDirectoryPaths: []string{
+			filepath.Join("..", "..", "config", "crd", "bases"),
+			filepath.Join("testdata", "crd"),
+		},
 		ErrorIfCRDPathMissing: true,
 	}
 
@@ -83,11 +107,29 @@ var _ = BeforeSuite(func() {
 	k8sClient, err = client.New(cfg, client.Options{Scheme: scheme.Scheme})
 	Expect(err).NotTo(HaveOccurred())
 	Expect(k8sClient).NotTo(BeNil())
+
+	By("starting the fake STACKIT API")
+	stackitAPI = stackitapi.NewServer(stackitapi.Options{})
+	cloudFactory = stackit.NewFactory(stackit.Options{
+		IaaSEndpoint:         stackitAPI.URL(),
+		LoadBalancerEndpoint: stackitAPI.URL(),
+		SKEEndpoint:          stackitAPI.URL(),
+	})
+
+	By("starting the fake object storage")
+	objectStorageAPI = s3api.NewServer(s3api.Options{AccessKeyID: objectStorageAccessKeyID})
+	objectStorageFactory = s3.NewFactory(s3.Options{})
 })
 
 var _ = AfterSuite(func() {
 	By("tearing down the test environment")
 	cancel()
+	if stackitAPI != nil {
+		stackitAPI.Close()
+	}
+	if objectStorageAPI != nil {
+		objectStorageAPI.Close()
+	}
 	err := testEnv.Stop()
 	Expect(err).NotTo(HaveOccurred())
 })
@@ -0,0 +1,132 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	clusterv1 "github.com/aniruddha2000/cluster-api-provider-stackit/internal/capi/v1beta1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/conditions"
+)
+
+// additionalVolumeName returns the name of the additional volume of
+// stackitMachine with the given device name.
+func additionalVolumeName(stackitMachine *infrastructurev1alpha1.StackitMachine, deviceName string) string {
+	return stackitMachine.Name + "-" + deviceName
+}
+
+// keptVolumeNames returns the names of the additional volumes which are not
+// deleted with stackitMachine.
+func keptVolumeNames(stackitMachine *infrastructurev1alpha1.StackitMachine) sets.Set[string] {
+	kept := sets.New[string]()
+	for _, spec := range stackitMachine.Spec.AdditionalVolumes {
+		if !ptr.Deref(spec.DeleteOnTermination, true) {
+			kept.Insert(additionalVolumeName(stackitMachine, spec.DeviceName))
+		}
+	}
+	return kept
+}
+
+// reconcileAdditionalVolumes creates the additional volumes of
+// stackitMachine in the availability zone of its server and returns their
+// IDs in the order of the spec. It returns false while a volume is not
+// available yet.
+func (r *StackitMachineReconciler) reconcileAdditionalVolumes(
+	ctx context.Context,
+	stackit cloud.Interface,
+	machine *clusterv1.Machine,
+	stackitCluster *infrastructurev1alpha1.StackitCluster,
+	stackitMachine *infrastructurev1alpha1.StackitMachine,
+) ([]string, bool, error) {
+	log := logf.FromContext(ctx)
+	if len(stackitMachine.Spec.AdditionalVolumes) == 0 {
+		return nil, true, nil
+	}
+
+	availabilityZone, err := machineAvailabilityZone(machine, stackitCluster, stackitMachine)
+	if err != nil {
+		return nil, false, err
+	}
+	labels := map[string]string{
+		cloud.ClusterUIDLabel: string(stackitCluster.UID),
+		cloud.MachineUIDLabel: string(stackitMachine.UID),
+	}
+	existing, err := stackit.Volumes().List(ctx, labels)
+	if err != nil {
+		return nil, false, fmt.Errorf("listing volumes: %w", err)
+	}
+
+	ids := make([]string, 0, len(stackitMachine.Spec.AdditionalVolumes))
+	status := make([]infrastructurev1alpha1.VolumeStatus, 0, len(stackitMachine.Spec.AdditionalVolumes))
+	var pending []string
+	for _, spec := range stackitMachine.Spec.AdditionalVolumes {
+		name := additionalVolumeName(stackitMachine, spec.DeviceName)
+		var volume *cloud.Volume
+		if i := slices.IndexFunc(existing, func(v cloud.Volume) bool { return v.Name == name }); i >= 0 {
+			volume = &existing[i]
+		} else {
+			req := cloud.CreateVolumeRequest{
+				Name:             name,
+				Size:             spec.Size,
+				PerformanceClass: spec.PerformanceClass,
+				AvailabilityZone: availabilityZone,
+				Labels:           labels,
+			}
+			if e := spec.Encryption; e != nil {
+				req.Encryption = &cloud.VolumeEncryption{
+					KeyRingID:      e.KeyRingID,
+					KeyID:          e.KeyID,
+					KeyVersion:     e.KeyVersion,
+					ServiceAccount: e.ServiceAccount,
+				}
+			}
+			volume, err = stackit.Volumes().Create(ctx, req)
+			if err != nil {
+				return nil, false, fmt.Errorf("creating volume %s: %w", name, err)
+			}
+			log.Info("Created volume", "volumeID", volume.ID, "deviceName", spec.DeviceName)
+		}
+		ids = append(ids, volume.ID)
+		status = append(status, infrastructurev1alpha1.VolumeStatus{DeviceName: spec.DeviceName, ID: volume.ID})
+
+		switch volume.Status {
+		case cloud.VolumeStatusAvailable:
+		case cloud.VolumeStatusError:
+			return nil, false, fmt.Errorf("volume %s is in state ERROR", volume.ID)
+		default:
+			pending = append(pending, fmt.Sprintf("%s (%s)", volume.ID, volume.Status))
+		}
+	}
+	stackitMachine.Status.AdditionalVolumes = status
+
+	if len(pending) > 0 {
+		log.Info("Waiting for the volumes to become available", "volumes", pending)
+		conditions.MarkFalse(stackitMachine, infrastructurev1alpha1.InstanceReadyCondition,
+			infrastructurev1alpha1.WaitingForVolumesReason, infrastructurev1alpha1.ConditionSeverityInfo,
+			"Waiting for volumes %v", pending)
+		return nil, false, nil
+	}
+	return ids, true, nil
+}
@@ -0,0 +1,316 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var stackitclusterlog = logf.Log.WithName("stackitcluster-resource")
+
+// SetupStackitClusterWebhookWithManager registers the webhook for StackitCluster in the manager.
+func SetupStackitClusterWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&infrastructurev1alpha1.StackitCluster{}).
+		WithValidator(&StackitClusterCustomValidator{}).
+		WithDefaulter(&StackitClusterCustomDefaulter{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-infrastructure-cluster-x-k8s-io-v1alpha1-stackitcluster,mutating=true,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=stackitclusters,verbs=create;update,versions=v1alpha1,name=mstackitcluster-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// StackitClusterCustomDefaulter sets default values on the StackitCluster
+// resource when it is created or updated.
+type StackitClusterCustomDefaulter struct{}
+
+var _ webhook.CustomDefaulter = &StackitClusterCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind StackitCluster.
+func (d *StackitClusterCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	stackitcluster, ok := obj.(*infrastructurev1alpha1.StackitCluster)
+	if !ok {
+		return fmt.Errorf("expected a StackitCluster object but got %T", obj)
+	}
+	stackitclusterlog.Info("Defaulting for StackitCluster", "name", stackitcluster.GetName())
+
+	spec := &stackitcluster.Spec
+	if spec.Region == "" {
+		spec.Region = infrastructurev1alpha1.DefaultRegion
+	}
+	if spec.ControlPlaneLoadBalancer.Type == "" {
+		spec.ControlPlaneLoadBalancer.Type = infrastructurev1alpha1.PublicLoadBalancer
+	}
+	if spec.SecurityGroups.ControlPlane == nil {
+		port := spec.ControlPlaneEndpoint.Port
+		if port == 0 {
+			port = spec.ControlPlaneLoadBalancer.Port
+		}
+		if port == 0 {
+			port = infrastructurev1alpha1.DefaultAPIServerPort
+		}
+		spec.SecurityGroups.ControlPlane = infrastructurev1alpha1.DefaultControlPlaneSecurityGroupRules(port)
+	}
+	if spec.SecurityGroups.CNI == "" {
+		spec.SecurityGroups.CNI = infrastructurev1alpha1.CNICalico
+	}
+	if spec.ControlPlaneServerGroup.Policy == "" {
+		spec.ControlPlaneServerGroup.Policy = infrastructurev1alpha1.ServerGroupPolicyAntiAffinity
+	}
+	defaultSecurityGroupRules(spec.SecurityGroups.ControlPlane)
+	defaultSecurityGroupRules(spec.SecurityGroups.Worker)
+	return nil
+}
+
+// defaultSecurityGroupRules defaults the port range and remote prefix of rules.
+func defaultSecurityGroupRules(rules []infrastructurev1alpha1.SecurityGroupRule) {
+	for i := range rules {
+		rule := &rules[i]
+		if rule.RemoteIPPrefix == "" {
+			rule.RemoteIPPrefix = "0.0.0.0/0"
+		}
+		if rule.PortRangeMax == 0 {
+			rule.PortRangeMax = rule.PortRangeMin
+		}
+	}
+}
+
+// +kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1alpha1-stackitcluster,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=stackitclusters,verbs=create;update,versions=v1alpha1,name=vstackitcluster-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// StackitClusterCustomValidator validates the StackitCluster resource when it
+// is created or updated.
+type StackitClusterCustomValidator struct{}
+
+var _ webhook.CustomValidator = &StackitClusterCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type StackitCluster.
+func (v *StackitClusterCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	stackitcluster, ok := obj.(*infrastructurev1alpha1.StackitCluster)
+	if !ok {
+		return nil, fmt.Errorf("expected a StackitCluster object but got %T", obj)
+	}
+	stackitclusterlog.Info("Validation for StackitCluster upon creation", "name", stackitcluster.GetName())
+
+	path := field.NewPath("spec")
+	allErrs := validateStackitClusterSpec(&stackitcluster.Spec, path)
+	// Only required on creation, so that clusters created without the webhook
+	// can still be updated, for example to remove their finalizer.
+	if stackitcluster.Spec.Network.ID == "" && stackitcluster.Spec.Network.CIDR == "" {
+		allErrs = append(allErrs, field.Required(path.Child("network"), "one of id or cidr must be set"))
+	}
+	return nil, toInvalid("StackitCluster", stackitcluster.Name, allErrs)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type StackitCluster.
+func (v *StackitClusterCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	stackitcluster, ok := newObj.(*infrastructurev1alpha1.StackitCluster)
+	if !ok {
+		return nil, fmt.Errorf("expected a StackitCluster object for the newObj but got %T", newObj)
+	}
+	old, ok := oldObj.(*infrastructurev1alpha1.StackitCluster)
+	if !ok {
+		return nil, fmt.Errorf("expected a StackitCluster object for the oldObj but got %T", oldObj)
+	}
+	stackitclusterlog.Info("Validation for StackitCluster upon update", "name", stackitcluster.GetName())
+
+	path := field.NewPath("spec")
+	allErrs := validateStackitClusterSpec(&stackitcluster.Spec, path)
+	allErrs = append(allErrs, immutable(path.Child("projectID"), old.Spec.ProjectID, stackitcluster.Spec.ProjectID)...)
+	// Objects created without the defaulting webhook may lack the region.
+	oldRegion := old.Spec.Region
+	if oldRegion == "" {
+		oldRegion = infrastructurev1alpha1.DefaultRegion
+	}
+	allErrs = append(allErrs, immutable(path.Child("region"), oldRegion, stackitcluster.Spec.Region)...)
+	allErrs = append(allErrs, immutable(path.Child("network", "id"), old.Spec.Network.ID, stackitcluster.Spec.Network.ID)...)
+	allErrs = append(allErrs, immutable(path.Child("network", "cidr"), old.Spec.Network.CIDR, stackitcluster.Spec.Network.CIDR)...)
+	allErrs = append(allErrs, immutable(path.Child("network", "prefix"), old.Spec.Network.Prefix, stackitcluster.Spec.Network.Prefix)...)
+	allErrs = append(allErrs, immutable(path.Child("securityGroups", "controlPlaneID"),
+		old.Spec.SecurityGroups.ControlPlaneID, stackitcluster.Spec.SecurityGroups.ControlPlaneID)...)
+	allErrs = append(allErrs, immutable(path.Child("securityGroups", "workerID"),
+		old.Spec.SecurityGroups.WorkerID, stackitcluster.Spec.SecurityGroups.WorkerID)...)
+	allErrs = append(allErrs, immutable(path.Child("controlPlaneLoadBalancer", "name"),
+		old.Spec.ControlPlaneLoadBalancer.Name, stackitcluster.Spec.ControlPlaneLoadBalancer.Name)...)
+	allErrs = append(allErrs, immutable(path.Child("controlPlaneLoadBalancer", "type"),
+		loadBalancerType(old.Spec.ControlPlaneLoadBalancer), loadBalancerType(stackitcluster.Spec.ControlPlaneLoadBalancer))...)
+	// The policy of an existing server group cannot be changed.
+	allErrs = append(allErrs, immutable(path.Child("controlPlaneServerGroup", "policy"),
+		serverGroupPolicy(old.Spec.ControlPlaneServerGroup.Policy), serverGroupPolicy(stackitcluster.Spec.ControlPlaneServerGroup.Policy))...)
+	// The bastion host is replaced by removing and adding it again.
+	if oldBastion, bastion := old.Spec.Bastion, stackitcluster.Spec.Bastion; oldBastion != nil && bastion != nil {
+		bastionPath := path.Child("bastion")
+		allErrs = append(allErrs, immutable(bastionPath.Child("machineType"), oldBastion.MachineType, bastion.MachineType)...)
+		allErrs = append(allErrs, immutable(bastionPath.Child("image", "id"), ptrValue(oldBastion.Image.ID), ptrValue(bastion.Image.ID))...)
+		allErrs = append(allErrs, immutable(bastionPath.Child("image", "name"), ptrValue(oldBastion.Image.Name), ptrValue(bastion.Image.Name))...)
+		if !equality.Semantic.DeepEqual(oldBastion.Image.Selector, bastion.Image.Selector) {
+			allErrs = append(allErrs, field.Forbidden(bastionPath.Child("image", "selector"), "field is immutable"))
+		}
+		allErrs = append(allErrs, immutable(bastionPath.Child("sshKeyName"), oldBastion.SSHKeyName, bastion.SSHKeyName)...)
+		allErrs = append(allErrs, immutable(bastionPath.Child("availabilityZone"), oldBastion.AvailabilityZone, bastion.AvailabilityZone)...)
+	}
+	return nil, toInvalid("StackitCluster", stackitcluster.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type StackitCluster.
+func (v *StackitClusterCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateStackitClusterSpec validates the fields of spec which are not
+// covered by the OpenAPI schema.
+func validateStackitClusterSpec(spec *infrastructurev1alpha1.StackitClusterSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	networkPath := path.Child("network")
+	if spec.Network.CIDR != "" {
+		allErrs = append(allErrs, validateIPv4Prefix(networkPath.Child("cidr"), spec.Network.CIDR)...)
+	}
+	if spec.Network.Prefix != "" {
+		if spec.Network.ID == "" {
+			allErrs = append(allErrs, field.Forbidden(networkPath.Child("prefix"), "may only be set together with id"))
+		} else {
+			allErrs = append(allErrs, validateIPv4Prefix(networkPath.Child("prefix"), spec.Network.Prefix)...)
+		}
+	}
+	for i, server := range spec.Network.NameServers {
+		if _, err := netip.ParseAddr(server); err != nil {
+			allErrs = append(allErrs, field.Invalid(networkPath.Child("nameServers").Index(i), server, "must be an IP address"))
+		}
+	}
+
+	lbPath := path.Child("controlPlaneLoadBalancer")
+	if spec.ControlPlaneLoadBalancer.Name != "" {
+		if len(spec.ControlPlaneLoadBalancer.AllowedSourceRanges) > 0 {
+			allErrs = append(allErrs, field.Forbidden(lbPath.Child("allowedSourceRanges"),
+				"is not managed for existing load balancers"))
+		}
+		if spec.ControlPlaneLoadBalancer.HealthCheck != nil {
+			allErrs = append(allErrs, field.Forbidden(lbPath.Child("healthCheck"),
+				"is not managed for existing load balancers"))
+		}
+	}
+	for i, cidr := range spec.ControlPlaneLoadBalancer.AllowedSourceRanges {
+		allErrs = append(allErrs, validateIPv4Prefix(lbPath.Child("allowedSourceRanges").Index(i), cidr)...)
+	}
+	if hc := spec.ControlPlaneLoadBalancer.HealthCheck; hc != nil && hc.IntervalSeconds != 0 && hc.TimeoutSeconds > hc.IntervalSeconds {
+		allErrs = append(allErrs, field.Invalid(lbPath.Child("healthCheck", "timeoutSeconds"), hc.TimeoutSeconds,
+			"must not be greater than intervalSeconds"))
+	}
+	if port := spec.ControlPlaneLoadBalancer.Port; port != 0 && spec.ControlPlaneEndpoint.Port != 0 && port != spec.ControlPlaneEndpoint.Port {
+		allErrs = append(allErrs, field.Invalid(lbPath.Child("port"), port, "must match controlPlaneEndpoint.port"))
+	}
+
+	sgPath := path.Child("securityGroups")
+	allErrs = append(allErrs, validateSecurityGroupRules(sgPath.Child("controlPlane"), spec.SecurityGroups.ControlPlane)...)
+	allErrs = append(allErrs, validateSecurityGroupRules(sgPath.Child("worker"), spec.SecurityGroups.Worker)...)
+
+	if spec.Bastion != nil {
+		imagePath := path.Child("bastion", "image")
+		allErrs = append(allErrs, validateImage(&spec.Bastion.Image, imagePath)...)
+		if selector := spec.Bastion.Image.Selector; selector != nil && selector.MatchKubernetesVersion {
+			allErrs = append(allErrs, field.Forbidden(imagePath.Child("selector", "matchKubernetesVersion"),
+				"the bastion host has no Kubernetes version"))
+		}
+		for i, cidr := range spec.Bastion.AllowedCIDRs {
+			allErrs = append(allErrs, validateIPv4Prefix(path.Child("bastion", "allowedCIDRs").Index(i), cidr)...)
+		}
+	}
+	return allErrs
+}
+
+// loadBalancerType returns the type of the load balancer configured by spec.
+func loadBalancerType(spec infrastructurev1alpha1.LoadBalancerSpec) infrastructurev1alpha1.LoadBalancerType {
+	if spec.Type == "" {
+		return infrastructurev1alpha1.PublicLoadBalancer
+	}
+	return spec.Type
+}
+
+// serverGroupPolicy returns policy, defaulting to anti-affinity.
+func serverGroupPolicy(policy infrastructurev1alpha1.ServerGroupPolicy) infrastructurev1alpha1.ServerGroupPolicy {
+	if policy == "" {
+		return infrastructurev1alpha1.ServerGroupPolicyAntiAffinity
+	}
+	return policy
+}
+
+// validateSecurityGroupRules validates the port ranges and remote prefixes
+// of rules.
+func validateSecurityGroupRules(path *field.Path, rules []infrastructurev1alpha1.SecurityGroupRule) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, rule := range rules {
+		rulePath := path.Index(i)
+		if rule.RemoteIPPrefix != "" {
+			allErrs = append(allErrs, validateIPv4Prefix(rulePath.Child("remoteIPPrefix"), rule.RemoteIPPrefix)...)
+		}
+		if rule.Protocol == "icmp" {
+			continue
+		}
+		if rule.PortRangeMin == 0 {
+			allErrs = append(allErrs, field.Required(rulePath.Child("portRangeMin"), "must be set for "+rule.Protocol))
+		}
+		if rule.PortRangeMax != 0 && rule.PortRangeMax < rule.PortRangeMin {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("portRangeMax"), rule.PortRangeMax,
+				"must not be less than portRangeMin"))
+		}
+	}
+	return allErrs
+}
+
+// validateIPv4Prefix validates that value is an IPv4 CIDR without host bits.
+func validateIPv4Prefix(path *field.Path, value string) field.ErrorList {
+	prefix, err := netip.ParsePrefix(value)
+	switch {
+	case err != nil:
+		return field.ErrorList{field.Invalid(path, value, "must be a CIDR, for example 10.0.0.0/24")}
+	case !prefix.Addr().Is4():
+		return field.ErrorList{field.Invalid(path, value, "must be an IPv4 CIDR")}
+	case prefix.Masked() != prefix:
+		return field.ErrorList{field.Invalid(path, value, "must not have host bits set, use "+prefix.Masked().String())}
+	}
+	return nil
+}
+
+// immutable returns an error if a field changed.
+func immutable[T comparable](path *field.Path, old, new T) field.ErrorList {
+	if old == new {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(path, "field is immutable")}
+}
+
+// toInvalid returns allErrs as an Invalid API error for the named object of
+// the given kind, or nil if allErrs is empty.
+func toInvalid(kind, name string, allErrs field.ErrorList) error {
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(infrastructurev1alpha1.GroupVersion.WithKind(kind).GroupKind(), name, allErrs)
+}
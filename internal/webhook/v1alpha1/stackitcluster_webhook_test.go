@@ -0,0 +1,234 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+)
+
+var _ = Describe("StackitCluster Webhook", func() {
+	var (
+		obj       *infrastructurev1alpha1.StackitCluster
+		oldObj    *infrastructurev1alpha1.StackitCluster
+		validator StackitClusterCustomValidator
+		defaulter StackitClusterCustomDefaulter
+	)
+
+	BeforeEach(func() {
+		obj = &infrastructurev1alpha1.StackitCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "webhook-test", Namespace: "default"},
+			Spec: infrastructurev1alpha1.StackitClusterSpec{
+				ProjectID: "00000000-0000-0000-0000-000000000000",
+				Network:   infrastructurev1alpha1.NetworkSpec{CIDR: "10.0.0.0/24"},
+			},
+		}
+		oldObj = obj.DeepCopy()
+		validator = StackitClusterCustomValidator{}
+		defaulter = StackitClusterCustomDefaulter{}
+	})
+
+	Context("When creating StackitCluster under Defaulting Webhook", func() {
+		It("Should default the region and control plane rules", func() {
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.Region).To(Equal(infrastructurev1alpha1.DefaultRegion))
+			Expect(obj.Spec.ControlPlaneLoadBalancer.Type).To(Equal(infrastructurev1alpha1.PublicLoadBalancer))
+			Expect(obj.Spec.SecurityGroups.ControlPlane).To(Equal(
+				infrastructurev1alpha1.DefaultControlPlaneSecurityGroupRules(infrastructurev1alpha1.DefaultAPIServerPort),
+			))
+			Expect(obj.Spec.SecurityGroups.CNI).To(Equal(infrastructurev1alpha1.CNICalico))
+			Expect(obj.Spec.ControlPlaneServerGroup.Policy).To(Equal(infrastructurev1alpha1.ServerGroupPolicyAntiAffinity))
+		})
+
+		It("Should allow the load balancer port in the default control plane rules", func() {
+			obj.Spec.ControlPlaneLoadBalancer.Port = 443
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.SecurityGroups.ControlPlane).To(Equal(
+				infrastructurev1alpha1.DefaultControlPlaneSecurityGroupRules(443),
+			))
+		})
+
+		It("Should keep configured rules and default their fields", func() {
+			obj.Spec.Region = "eu02"
+			obj.Spec.SecurityGroups.ControlPlane = []infrastructurev1alpha1.SecurityGroupRule{}
+			obj.Spec.SecurityGroups.Worker = []infrastructurev1alpha1.SecurityGroupRule{
+				{Protocol: "tcp", PortRangeMin: 30000},
+			}
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.Region).To(Equal("eu02"))
+			Expect(obj.Spec.SecurityGroups.ControlPlane).To(BeEmpty())
+			Expect(obj.Spec.SecurityGroups.Worker).To(ConsistOf(infrastructurev1alpha1.SecurityGroupRule{
+				Protocol: "tcp", PortRangeMin: 30000, PortRangeMax: 30000, RemoteIPPrefix: "0.0.0.0/0",
+			}))
+		})
+	})
+
+	Context("When creating or updating StackitCluster under Validating Webhook", func() {
+		It("Should deny creation with an invalid network CIDR", func() {
+			for _, cidr := range []string{"10.0.0.0", "10.0.0.1/24", "fd00::/64"} {
+				obj.Spec.Network.CIDR = cidr
+				_, err := validator.ValidateCreate(ctx, obj)
+				Expect(err).To(MatchError(ContainSubstring("spec.network.cidr")), cidr)
+			}
+		})
+
+		It("Should deny creation without a network ID or CIDR", func() {
+			obj.Spec.Network = infrastructurev1alpha1.NetworkSpec{}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.network: Required value: one of id or cidr must be set")))
+
+			obj.Spec.Network.ID = "11111111-1111-1111-1111-111111111111"
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should admit updates of clusters without a network ID or CIDR", func() {
+			oldObj.Spec.Network = infrastructurev1alpha1.NetworkSpec{}
+			oldObj.Spec.Region = infrastructurev1alpha1.DefaultRegion
+			oldObj.Finalizers = []string{infrastructurev1alpha1.ClusterFinalizer}
+			obj = oldObj.DeepCopy()
+			obj.Finalizers = nil
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny creation with invalid rules", func() {
+			obj.Spec.SecurityGroups.Worker = []infrastructurev1alpha1.SecurityGroupRule{
+				{Protocol: "tcp", PortRangeMin: 80, PortRangeMax: 79, RemoteIPPrefix: "all"},
+				{Protocol: "udp"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.securityGroups.worker[0].remoteIPPrefix")))
+			Expect(err).To(MatchError(ContainSubstring("spec.securityGroups.worker[0].portRangeMax")))
+			Expect(err).To(MatchError(ContainSubstring("spec.securityGroups.worker[1].portRangeMin")))
+		})
+
+		It("Should deny creation with an invalid load balancer", func() {
+			obj.Spec.ControlPlaneEndpoint.Port = 6443
+			obj.Spec.ControlPlaneLoadBalancer = infrastructurev1alpha1.LoadBalancerSpec{
+				Port:                443,
+				AllowedSourceRanges: []string{"192.0.2.0/24", "192.0.2.1"},
+				HealthCheck:         &infrastructurev1alpha1.HealthCheckSpec{IntervalSeconds: 5, TimeoutSeconds: 10},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.controlPlaneLoadBalancer.port")))
+			Expect(err).To(MatchError(ContainSubstring("spec.controlPlaneLoadBalancer.allowedSourceRanges[1]")))
+			Expect(err).NotTo(MatchError(ContainSubstring("spec.controlPlaneLoadBalancer.allowedSourceRanges[0]")))
+			Expect(err).To(MatchError(ContainSubstring("spec.controlPlaneLoadBalancer.healthCheck.timeoutSeconds")))
+		})
+
+		It("Should deny settings of unmanaged infrastructure", func() {
+			obj.Spec.Network = infrastructurev1alpha1.NetworkSpec{Prefix: "10.0.0.0/24"}
+			obj.Spec.ControlPlaneLoadBalancer = infrastructurev1alpha1.LoadBalancerSpec{
+				Name:                "existing",
+				AllowedSourceRanges: []string{"192.0.2.0/24"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.network.prefix")))
+			Expect(err).To(MatchError(ContainSubstring("spec.controlPlaneLoadBalancer.allowedSourceRanges")))
+		})
+
+		It("Should admit creation of a valid cluster", func() {
+			obj.Spec.Network.NameServers = []string{"1.1.1.1"}
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny changes of immutable fields", func() {
+			oldObj.Spec.Region = "eu01"
+			obj.Spec.Region = "eu02"
+			obj.Spec.ProjectID = "11111111-1111-1111-1111-111111111111"
+			obj.Spec.Network.CIDR = "10.1.0.0/24"
+			obj.Spec.ControlPlaneLoadBalancer.Type = infrastructurev1alpha1.InternalLoadBalancer
+			obj.Spec.ControlPlaneLoadBalancer.Name = "existing"
+			obj.Spec.SecurityGroups.WorkerID = "existing"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.projectID")))
+			Expect(err).To(MatchError(ContainSubstring("spec.region")))
+			Expect(err).To(MatchError(ContainSubstring("spec.network.cidr")))
+			Expect(err).To(MatchError(ContainSubstring("spec.controlPlaneLoadBalancer.type")))
+			Expect(err).To(MatchError(ContainSubstring("spec.controlPlaneLoadBalancer.name")))
+			Expect(err).To(MatchError(ContainSubstring("spec.securityGroups.workerID")))
+		})
+
+		It("Should validate the bastion host", func() {
+			bastion := &infrastructurev1alpha1.BastionSpec{
+				MachineType:  "c1.1",
+				Image:        infrastructurev1alpha1.ImageSpec{Name: ptr.To("ubuntu-22.04")},
+				AllowedCIDRs: []string{"198.51.100.1/24"},
+			}
+			obj.Spec.Region = infrastructurev1alpha1.DefaultRegion
+			obj.Spec.Bastion = bastion
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.bastion.allowedCIDRs[0]")))
+
+			By("adding, changing and removing the bastion host")
+			bastion.AllowedCIDRs = []string{"198.51.100.0/24"}
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+			oldObj.Spec.Bastion = bastion.DeepCopy()
+			obj.Spec.Bastion.AllowedCIDRs = []string{"203.0.113.0/24"}
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+			obj.Spec.Bastion.MachineType = "c1.2"
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.bastion.machineType")))
+			obj.Spec.Bastion = nil
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny changes of the control plane server group policy", func() {
+			obj.Spec.Region = infrastructurev1alpha1.DefaultRegion
+			obj.Spec.ControlPlaneServerGroup.Policy = infrastructurev1alpha1.ServerGroupPolicyAntiAffinity
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+			obj.Spec.ControlPlaneServerGroup.Policy = infrastructurev1alpha1.ServerGroupPolicySoftAntiAffinity
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.controlPlaneServerGroup.policy")))
+			obj.Spec.ControlPlaneServerGroup = infrastructurev1alpha1.ControlPlaneServerGroupSpec{Disabled: true}
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny selecting the bastion image by Kubernetes version", func() {
+			obj.Spec.Bastion = &infrastructurev1alpha1.BastionSpec{
+				MachineType: "c1.1",
+				Image: infrastructurev1alpha1.ImageSpec{Selector: &infrastructurev1alpha1.ImageSelector{
+					NamePattern:            "ubuntu-*",
+					MatchKubernetesVersion: true,
+				}},
+				AllowedCIDRs: []string{"198.51.100.0/24"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.bastion.image.selector.matchKubernetesVersion")))
+		})
+
+		It("Should admit the defaulted region of clusters created without it", func() {
+			obj.Spec.Region = infrastructurev1alpha1.DefaultRegion
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should be called by the API server", func() {
+			obj.Spec.Network.CIDR = "10.0.0.1/24"
+			err := k8sClient.Create(ctx, obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+
+			obj.Spec.Network.CIDR = "10.0.0.0/24"
+			Expect(k8sClient.Create(ctx, obj)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, obj)
+			Expect(obj.Spec.Region).To(Equal(infrastructurev1alpha1.DefaultRegion))
+		})
+	})
+})
@@ -0,0 +1,70 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+)
+
+var _ = Describe("StackitClusterTemplate Webhook", func() {
+	var (
+		obj       *infrastructurev1alpha1.StackitClusterTemplate
+		oldObj    *infrastructurev1alpha1.StackitClusterTemplate
+		validator StackitClusterTemplateCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &infrastructurev1alpha1.StackitClusterTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "webhook-test", Namespace: "default"},
+			Spec: infrastructurev1alpha1.StackitClusterTemplateSpec{
+				Template: infrastructurev1alpha1.StackitClusterTemplateResource{
+					Spec: infrastructurev1alpha1.StackitClusterSpec{
+						ProjectID: "00000000-0000-0000-0000-000000000000",
+						Network:   infrastructurev1alpha1.NetworkSpec{CIDR: "10.0.0.0/24"},
+					},
+				},
+			},
+		}
+		oldObj = obj.DeepCopy()
+		validator = StackitClusterTemplateCustomValidator{}
+	})
+
+	Context("When creating or updating StackitClusterTemplate under Validating Webhook", func() {
+		It("Should validate the cluster spec of the template", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+
+			obj.Spec.Template.Spec.Network.CIDR = "10.0.0.1/24"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.template.spec.network.cidr")))
+		})
+
+		It("Should deny changes of the template spec", func() {
+			obj.Spec.Template.Spec.Network.CIDR = "10.1.0.0/24"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.template.spec: Forbidden: field is immutable")))
+		})
+
+		It("Should admit changes of the template metadata", func() {
+			obj.Spec.Template.ObjectMeta.Annotations = map[string]string{"owner": "team-a"}
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+	})
+})
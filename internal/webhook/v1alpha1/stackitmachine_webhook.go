@@ -0,0 +1,220 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	gopath "path"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud/s3"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var stackitmachinelog = logf.Log.WithName("stackitmachine-resource")
+
+// SetupStackitMachineWebhookWithManager registers the webhook for StackitMachine in the manager.
+func SetupStackitMachineWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&infrastructurev1alpha1.StackitMachine{}).
+		WithValidator(&StackitMachineCustomValidator{}).
+		WithDefaulter(&StackitMachineCustomDefaulter{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-infrastructure-cluster-x-k8s-io-v1alpha1-stackitmachine,mutating=true,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=stackitmachines,verbs=create;update,versions=v1alpha1,name=mstackitmachine-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// StackitMachineCustomDefaulter sets default values on the StackitMachine
+// resource when it is created or updated.
+type StackitMachineCustomDefaulter struct{}
+
+var _ webhook.CustomDefaulter = &StackitMachineCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind StackitMachine.
+func (d *StackitMachineCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	stackitmachine, ok := obj.(*infrastructurev1alpha1.StackitMachine)
+	if !ok {
+		return fmt.Errorf("expected a StackitMachine object but got %T", obj)
+	}
+	stackitmachinelog.Info("Defaulting for StackitMachine", "name", stackitmachine.GetName())
+
+	if stackitmachine.Spec.BootVolume.Size == 0 {
+		stackitmachine.Spec.BootVolume.Size = infrastructurev1alpha1.DefaultBootVolumeSize
+	}
+	for i := range stackitmachine.Spec.AdditionalVolumes {
+		if stackitmachine.Spec.AdditionalVolumes[i].DeleteOnTermination == nil {
+			stackitmachine.Spec.AdditionalVolumes[i].DeleteOnTermination = ptr.To(true)
+		}
+	}
+	if ignition := stackitmachine.Spec.Ignition; ignition != nil && ignition.ObjectStorage.PresignedURLExpiry == nil {
+		ignition.ObjectStorage.PresignedURLExpiry = &metav1.Duration{Duration: infrastructurev1alpha1.DefaultPresignedURLExpiry}
+	}
+	if group := stackitmachine.Spec.ServerGroup; group != nil && group.Name != "" && group.Policy == "" {
+		group.Policy = infrastructurev1alpha1.ServerGroupPolicyAntiAffinity
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1alpha1-stackitmachine,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=stackitmachines,verbs=create;update,versions=v1alpha1,name=vstackitmachine-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// StackitMachineCustomValidator validates the StackitMachine resource when it
+// is created or updated.
+type StackitMachineCustomValidator struct{}
+
+var _ webhook.CustomValidator = &StackitMachineCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type StackitMachine.
+func (v *StackitMachineCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	stackitmachine, ok := obj.(*infrastructurev1alpha1.StackitMachine)
+	if !ok {
+		return nil, fmt.Errorf("expected a StackitMachine object but got %T", obj)
+	}
+	stackitmachinelog.Info("Validation for StackitMachine upon creation", "name", stackitmachine.GetName())
+
+	return nil, toInvalid("StackitMachine", stackitmachine.Name,
+		validateStackitMachineSpec(&stackitmachine.Spec, field.NewPath("spec")))
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type StackitMachine.
+func (v *StackitMachineCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	stackitmachine, ok := newObj.(*infrastructurev1alpha1.StackitMachine)
+	if !ok {
+		return nil, fmt.Errorf("expected a StackitMachine object for the newObj but got %T", newObj)
+	}
+	old, ok := oldObj.(*infrastructurev1alpha1.StackitMachine)
+	if !ok {
+		return nil, fmt.Errorf("expected a StackitMachine object for the oldObj but got %T", oldObj)
+	}
+	stackitmachinelog.Info("Validation for StackitMachine upon update", "name", stackitmachine.GetName())
+
+	path := field.NewPath("spec")
+	allErrs := validateStackitMachineSpec(&stackitmachine.Spec, path)
+	allErrs = append(allErrs, immutable(path.Child("machineType"), old.Spec.MachineType, stackitmachine.Spec.MachineType)...)
+	allErrs = append(allErrs, immutable(path.Child("image", "id"), ptrValue(old.Spec.Image.ID), ptrValue(stackitmachine.Spec.Image.ID))...)
+	allErrs = append(allErrs, immutable(path.Child("image", "name"), ptrValue(old.Spec.Image.Name), ptrValue(stackitmachine.Spec.Image.Name))...)
+	if !equality.Semantic.DeepEqual(old.Spec.Image.Selector, stackitmachine.Spec.Image.Selector) {
+		allErrs = append(allErrs, field.Forbidden(path.Child("image", "selector"), "field is immutable"))
+	}
+	allErrs = append(allErrs, immutable(path.Child("availabilityZone"), old.Spec.AvailabilityZone, stackitmachine.Spec.AvailabilityZone)...)
+	allErrs = append(allErrs, immutable(path.Child("publicIP"), old.Spec.PublicIP != nil, stackitmachine.Spec.PublicIP != nil)...)
+	allErrs = append(allErrs, immutable(path.Child("publicIP", "id"), ptrValue(old.Spec.PublicIP).ID, ptrValue(stackitmachine.Spec.PublicIP).ID)...)
+	// Volumes are only created together with the server.
+	if !equality.Semantic.DeepEqual(old.Spec.AdditionalVolumes, stackitmachine.Spec.AdditionalVolumes) {
+		allErrs = append(allErrs, field.Forbidden(path.Child("additionalVolumes"), "field is immutable"))
+	}
+	// The uploaded Ignition config is deleted from the bucket it was uploaded to.
+	oldStorage, newStorage := ptrValue(old.Spec.Ignition).ObjectStorage, ptrValue(stackitmachine.Spec.Ignition).ObjectStorage
+	allErrs = append(allErrs, immutable(path.Child("ignition"), old.Spec.Ignition != nil, stackitmachine.Spec.Ignition != nil)...)
+	allErrs = append(allErrs, immutable(path.Child("ignition", "objectStorage", "bucket"), oldStorage.Bucket, newStorage.Bucket)...)
+	allErrs = append(allErrs, immutable(path.Child("ignition", "objectStorage", "endpoint"), oldStorage.Endpoint, newStorage.Endpoint)...)
+	// Servers cannot change their server group.
+	oldGroup, newGroup := ptrValue(old.Spec.ServerGroup), ptrValue(stackitmachine.Spec.ServerGroup)
+	allErrs = append(allErrs, immutable(path.Child("serverGroup"), old.Spec.ServerGroup != nil, stackitmachine.Spec.ServerGroup != nil)...)
+	allErrs = append(allErrs, immutable(path.Child("serverGroup", "id"), oldGroup.ID, newGroup.ID)...)
+	allErrs = append(allErrs, immutable(path.Child("serverGroup", "name"), oldGroup.Name, newGroup.Name)...)
+	if oldGroup.Name != "" {
+		allErrs = append(allErrs, immutable(path.Child("serverGroup", "policy"),
+			serverGroupPolicy(oldGroup.Policy), serverGroupPolicy(newGroup.Policy))...)
+	}
+	// The provider ID is set once by the controller.
+	if old.Spec.ProviderID != nil {
+		allErrs = append(allErrs, immutable(path.Child("providerID"), *old.Spec.ProviderID, ptrValue(stackitmachine.Spec.ProviderID))...)
+	}
+	return nil, toInvalid("StackitMachine", stackitmachine.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type StackitMachine.
+func (v *StackitMachineCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateStackitMachineSpec validates the fields of spec which are not
+// covered by the OpenAPI schema.
+func validateStackitMachineSpec(spec *infrastructurev1alpha1.StackitMachineSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.ProviderID != nil {
+		if _, err := cloud.ServerIDFromProviderID(*spec.ProviderID); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("providerID"), *spec.ProviderID, err.Error()))
+		}
+	}
+	allErrs = append(allErrs, validateImage(&spec.Image, path.Child("image"))...)
+	if group := spec.ServerGroup; group != nil && group.Name == infrastructurev1alpha1.ControlPlaneServerGroupName {
+		allErrs = append(allErrs, field.Forbidden(path.Child("serverGroup", "name"),
+			fmt.Sprintf("%q is reserved for the server group of the control plane", group.Name)))
+	}
+	if spec.Ignition != nil {
+		allErrs = append(allErrs, validateObjectStorage(&spec.Ignition.ObjectStorage, path.Child("ignition", "objectStorage"))...)
+	}
+	return allErrs
+}
+
+// validateImage validates the image selector of spec.
+func validateImage(spec *infrastructurev1alpha1.ImageSpec, path *field.Path) field.ErrorList {
+	selector := spec.Selector
+	if selector == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	selectorPath := path.Child("selector")
+	if selector.NamePattern == "" && len(selector.MatchLabels) == 0 && !selector.MatchKubernetesVersion && selector.OS == nil {
+		allErrs = append(allErrs, field.Required(selectorPath, "at least one of namePattern, matchLabels, matchKubernetesVersion or os must be set"))
+	}
+	if _, err := gopath.Match(selector.NamePattern, ""); err != nil {
+		allErrs = append(allErrs, field.Invalid(selectorPath.Child("namePattern"), selector.NamePattern, err.Error()))
+	}
+	return allErrs
+}
+
+// validateObjectStorage validates the bucket Ignition configs are uploaded to.
+func validateObjectStorage(spec *infrastructurev1alpha1.ObjectStorageSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.Endpoint != "" {
+		if u, err := url.Parse(spec.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(path.Child("endpoint"), spec.Endpoint, "must be an http or https URL"))
+		}
+	}
+	if spec.CredentialsSecret.Name == "" {
+		allErrs = append(allErrs, field.Required(path.Child("credentialsSecret", "name"), ""))
+	}
+	if d := spec.PresignedURLExpiry; d != nil && (d.Duration < time.Second || d.Duration > s3.MaxPresignExpiry) {
+		allErrs = append(allErrs, field.Invalid(path.Child("presignedURLExpiry"), d.Duration.String(),
+			fmt.Sprintf("must be between 1s and %s", s3.MaxPresignExpiry)))
+	}
+	return allErrs
+}
+
+func ptrValue[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
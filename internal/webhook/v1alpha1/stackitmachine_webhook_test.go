@@ -0,0 +1,226 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+)
+
+var _ = Describe("StackitMachine Webhook", func() {
+	var (
+		obj       *infrastructurev1alpha1.StackitMachine
+		oldObj    *infrastructurev1alpha1.StackitMachine
+		validator StackitMachineCustomValidator
+		defaulter StackitMachineCustomDefaulter
+	)
+
+	BeforeEach(func() {
+		obj = &infrastructurev1alpha1.StackitMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "webhook-test", Namespace: "default"},
+			Spec: infrastructurev1alpha1.StackitMachineSpec{
+				MachineType: "c1.2",
+				Image:       infrastructurev1alpha1.ImageSpec{Name: ptr.To("ubuntu-22.04")},
+			},
+		}
+		oldObj = obj.DeepCopy()
+		validator = StackitMachineCustomValidator{}
+		defaulter = StackitMachineCustomDefaulter{}
+	})
+
+	Context("When creating StackitMachine under Defaulting Webhook", func() {
+		It("Should default the boot volume size", func() {
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.BootVolume.Size).To(BeEquivalentTo(infrastructurev1alpha1.DefaultBootVolumeSize))
+
+			obj.Spec.BootVolume.Size = 100
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.BootVolume.Size).To(BeEquivalentTo(100))
+		})
+
+		It("Should delete additional volumes on termination by default", func() {
+			obj.Spec.AdditionalVolumes = []infrastructurev1alpha1.AdditionalVolumeSpec{
+				{DeviceName: "etcd", Size: 10},
+				{DeviceName: "data", Size: 100, DeleteOnTermination: ptr.To(false)},
+			}
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.AdditionalVolumes[0].DeleteOnTermination).To(HaveValue(BeTrue()))
+			Expect(obj.Spec.AdditionalVolumes[1].DeleteOnTermination).To(HaveValue(BeFalse()))
+		})
+
+		It("Should default the expiry of the Ignition config URL", func() {
+			obj.Spec.Ignition = &infrastructurev1alpha1.IgnitionSpec{}
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.Ignition.ObjectStorage.PresignedURLExpiry).To(Equal(
+				&metav1.Duration{Duration: infrastructurev1alpha1.DefaultPresignedURLExpiry}))
+		})
+
+		It("Should default the policy of named server groups", func() {
+			obj.Spec.ServerGroup = &infrastructurev1alpha1.ServerGroupSpec{Name: "md-0"}
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.ServerGroup.Policy).To(Equal(infrastructurev1alpha1.ServerGroupPolicyAntiAffinity))
+
+			obj.Spec.ServerGroup = &infrastructurev1alpha1.ServerGroupSpec{ID: "existing"}
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.ServerGroup.Policy).To(BeEmpty())
+		})
+	})
+
+	Context("When creating or updating StackitMachine under Validating Webhook", func() {
+		It("Should deny creation with an invalid provider ID", func() {
+			obj.Spec.ProviderID = ptr.To("aws:///i-123")
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.providerID")))
+
+			obj.Spec.ProviderID = ptr.To("stackit:///0b5a1e3c")
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should admit setting the provider ID once", func() {
+			obj.Spec.ProviderID = ptr.To("stackit:///0b5a1e3c")
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+
+			oldObj.Spec.ProviderID = ptr.To("stackit:///0b5a1e3c")
+			obj.Spec.ProviderID = ptr.To("stackit:///d2f4a6b8")
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.providerID")))
+		})
+
+		It("Should deny changes of the machine type and image", func() {
+			obj.Spec.MachineType = "c1.4"
+			obj.Spec.Image = infrastructurev1alpha1.ImageSpec{ID: ptr.To("image-id")}
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.machineType")))
+			Expect(err).To(MatchError(ContainSubstring("spec.image.id")))
+			Expect(err).To(MatchError(ContainSubstring("spec.image.name")))
+		})
+
+		It("Should deny changes of the public IP", func() {
+			obj.Spec.PublicIP = &infrastructurev1alpha1.PublicIPSpec{}
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.publicIP")))
+
+			oldObj.Spec.PublicIP = &infrastructurev1alpha1.PublicIPSpec{}
+			obj.Spec.PublicIP = &infrastructurev1alpha1.PublicIPSpec{ID: "ip-id"}
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.publicIP.id")))
+		})
+
+		It("Should deny changes of the additional volumes", func() {
+			oldObj.Spec.AdditionalVolumes = []infrastructurev1alpha1.AdditionalVolumeSpec{
+				{DeviceName: "etcd", Size: 10, DeleteOnTermination: ptr.To(true)},
+			}
+			obj.Spec.AdditionalVolumes = nil
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.additionalVolumes")))
+
+			obj.Spec.AdditionalVolumes = []infrastructurev1alpha1.AdditionalVolumeSpec{
+				{DeviceName: "etcd", Size: 20, DeleteOnTermination: ptr.To(true)},
+			}
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.additionalVolumes")))
+
+			obj.Spec.AdditionalVolumes = oldObj.DeepCopy().Spec.AdditionalVolumes
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should validate the Ignition object storage", func() {
+			obj.Spec.Ignition = &infrastructurev1alpha1.IgnitionSpec{
+				ObjectStorage: infrastructurev1alpha1.ObjectStorageSpec{
+					Bucket:             "bootstrap",
+					Endpoint:           "object.storage.eu01.onstackit.cloud",
+					PresignedURLExpiry: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.ignition.objectStorage.endpoint")))
+			Expect(err).To(MatchError(ContainSubstring("spec.ignition.objectStorage.credentialsSecret.name")))
+			Expect(err).To(MatchError(ContainSubstring("spec.ignition.objectStorage.presignedURLExpiry")))
+
+			obj.Spec.Ignition.ObjectStorage.Endpoint = "https://object.storage.eu01.onstackit.cloud"
+			obj.Spec.Ignition.ObjectStorage.CredentialsSecret = corev1.LocalObjectReference{Name: "object-storage"}
+			obj.Spec.Ignition.ObjectStorage.PresignedURLExpiry = &metav1.Duration{Duration: 2 * time.Hour}
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny changes of the Ignition bucket", func() {
+			obj.Spec.Ignition = &infrastructurev1alpha1.IgnitionSpec{
+				ObjectStorage: infrastructurev1alpha1.ObjectStorageSpec{
+					Bucket:            "bootstrap",
+					CredentialsSecret: corev1.LocalObjectReference{Name: "object-storage"},
+				},
+			}
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.ignition")))
+
+			oldObj.Spec.Ignition = obj.Spec.Ignition.DeepCopy()
+			obj.Spec.Ignition.ObjectStorage.Bucket = "other"
+			obj.Spec.Ignition.ObjectStorage.CredentialsSecret.Name = "rotated"
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.ignition.objectStorage.bucket")))
+			Expect(err).NotTo(MatchError(ContainSubstring("credentialsSecret")))
+		})
+
+		It("Should deny changes of the server group", func() {
+			obj.Spec.ServerGroup = &infrastructurev1alpha1.ServerGroupSpec{Name: "md-0"}
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.serverGroup")))
+
+			oldObj.Spec.ServerGroup = obj.Spec.ServerGroup.DeepCopy()
+			obj.Spec.ServerGroup.Policy = infrastructurev1alpha1.ServerGroupPolicyAntiAffinity
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+			obj.Spec.ServerGroup.Policy = infrastructurev1alpha1.ServerGroupPolicySoftAntiAffinity
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.serverGroup.policy")))
+		})
+
+		It("Should deny the server group name of the control plane", func() {
+			obj.Spec.ServerGroup = &infrastructurev1alpha1.ServerGroupSpec{Name: infrastructurev1alpha1.ControlPlaneServerGroupName}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.serverGroup.name: Forbidden")))
+
+			obj.Spec.ServerGroup.Name = "control-plane-workers"
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should validate the image selector", func() {
+			obj.Spec.Image = infrastructurev1alpha1.ImageSpec{Selector: &infrastructurev1alpha1.ImageSelector{}}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.image.selector: Required value")))
+
+			obj.Spec.Image.Selector.NamePattern = "capi-[ubuntu"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.image.selector.namePattern")))
+
+			obj.Spec.Image.Selector.NamePattern = "capi-ubuntu-*"
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+
+			By("denying changes of the selector")
+			oldObj.Spec.Image = *obj.Spec.Image.DeepCopy()
+			obj.Spec.Image.Selector.MatchKubernetesVersion = true
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.image.selector")))
+		})
+	})
+})
@@ -0,0 +1,131 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var stackitmachinepoollog = logf.Log.WithName("stackitmachinepool-resource")
+
+// SetupStackitMachinePoolWebhookWithManager registers the webhook for StackitMachinePool in the manager.
+func SetupStackitMachinePoolWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&infrastructurev1alpha1.StackitMachinePool{}).
+		WithValidator(&StackitMachinePoolCustomValidator{}).
+		WithDefaulter(&StackitMachinePoolCustomDefaulter{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-infrastructure-cluster-x-k8s-io-v1alpha1-stackitmachinepool,mutating=true,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=stackitmachinepools,verbs=create;update,versions=v1alpha1,name=mstackitmachinepool-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// StackitMachinePoolCustomDefaulter sets default values on the
+// StackitMachinePool resource when it is created or updated.
+type StackitMachinePoolCustomDefaulter struct{}
+
+var _ webhook.CustomDefaulter = &StackitMachinePoolCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind StackitMachinePool.
+func (d *StackitMachinePoolCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	stackitmachinepool, ok := obj.(*infrastructurev1alpha1.StackitMachinePool)
+	if !ok {
+		return fmt.Errorf("expected a StackitMachinePool object but got %T", obj)
+	}
+	stackitmachinepoollog.Info("Defaulting for StackitMachinePool", "name", stackitmachinepool.GetName())
+
+	if stackitmachinepool.Spec.Template.BootVolume.Size == 0 {
+		stackitmachinepool.Spec.Template.BootVolume.Size = infrastructurev1alpha1.DefaultBootVolumeSize
+	}
+	strategy := &stackitmachinepool.Spec.Strategy
+	if strategy.MaxSurge == nil {
+		strategy.MaxSurge = ptr.To[int32](infrastructurev1alpha1.DefaultMaxSurge)
+	}
+	if strategy.MaxUnavailable == nil {
+		strategy.MaxUnavailable = ptr.To[int32](0)
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1alpha1-stackitmachinepool,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=stackitmachinepools,verbs=create;update,versions=v1alpha1,name=vstackitmachinepool-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// StackitMachinePoolCustomValidator validates the StackitMachinePool resource
+// when it is created or updated.
+type StackitMachinePoolCustomValidator struct{}
+
+var _ webhook.CustomValidator = &StackitMachinePoolCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type StackitMachinePool.
+func (v *StackitMachinePoolCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	stackitmachinepool, ok := obj.(*infrastructurev1alpha1.StackitMachinePool)
+	if !ok {
+		return nil, fmt.Errorf("expected a StackitMachinePool object but got %T", obj)
+	}
+	stackitmachinepoollog.Info("Validation for StackitMachinePool upon creation", "name", stackitmachinepool.GetName())
+
+	return nil, toInvalid("StackitMachinePool", stackitmachinepool.Name,
+		validateStackitMachinePoolSpec(&stackitmachinepool.Spec, field.NewPath("spec")))
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type StackitMachinePool.
+// The template may change, the servers of the pool are replaced then.
+func (v *StackitMachinePoolCustomValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	stackitmachinepool, ok := newObj.(*infrastructurev1alpha1.StackitMachinePool)
+	if !ok {
+		return nil, fmt.Errorf("expected a StackitMachinePool object for the newObj but got %T", newObj)
+	}
+	stackitmachinepoollog.Info("Validation for StackitMachinePool upon update", "name", stackitmachinepool.GetName())
+
+	return nil, toInvalid("StackitMachinePool", stackitmachinepool.Name,
+		validateStackitMachinePoolSpec(&stackitmachinepool.Spec, field.NewPath("spec")))
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type StackitMachinePool.
+func (v *StackitMachinePoolCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateStackitMachinePoolSpec validates the fields of spec which are not
+// covered by the OpenAPI schema.
+func validateStackitMachinePoolSpec(spec *infrastructurev1alpha1.StackitMachinePoolSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, providerID := range spec.ProviderIDList {
+		if _, err := cloud.ServerIDFromProviderID(providerID); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("providerIDList").Index(i), providerID, err.Error()))
+		}
+	}
+	allErrs = append(allErrs, validateImage(&spec.Template.Image, path.Child("template", "image"))...)
+	// Servers could neither be created nor deleted while replacing them.
+	strategy := spec.Strategy
+	if ptr.Deref(strategy.MaxSurge, infrastructurev1alpha1.DefaultMaxSurge) == 0 && ptr.Deref(strategy.MaxUnavailable, 0) == 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("strategy", "maxSurge"), 0,
+			"must not be 0 if maxUnavailable is 0"))
+	}
+	return allErrs
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+)
+
+var _ = Describe("StackitMachinePool Webhook", func() {
+	var (
+		obj       *infrastructurev1alpha1.StackitMachinePool
+		oldObj    *infrastructurev1alpha1.StackitMachinePool
+		validator StackitMachinePoolCustomValidator
+		defaulter StackitMachinePoolCustomDefaulter
+	)
+
+	BeforeEach(func() {
+		obj = &infrastructurev1alpha1.StackitMachinePool{
+			ObjectMeta: metav1.ObjectMeta{Name: "webhook-test", Namespace: "default"},
+			Spec: infrastructurev1alpha1.StackitMachinePoolSpec{
+				Template: infrastructurev1alpha1.StackitMachinePoolTemplate{
+					MachineType: "c1.2",
+					Image:       infrastructurev1alpha1.ImageSpec{Name: ptr.To("ubuntu-22.04")},
+				},
+			},
+		}
+		oldObj = obj.DeepCopy()
+		validator = StackitMachinePoolCustomValidator{}
+		defaulter = StackitMachinePoolCustomDefaulter{}
+	})
+
+	Context("When creating StackitMachinePool under Defaulting Webhook", func() {
+		It("Should default the boot volume size and the strategy", func() {
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.Template.BootVolume.Size).To(BeEquivalentTo(infrastructurev1alpha1.DefaultBootVolumeSize))
+			Expect(obj.Spec.Strategy.MaxSurge).To(HaveValue(BeEquivalentTo(infrastructurev1alpha1.DefaultMaxSurge)))
+			Expect(obj.Spec.Strategy.MaxUnavailable).To(HaveValue(BeEquivalentTo(0)))
+
+			obj.Spec.Strategy.MaxSurge = ptr.To[int32](0)
+			obj.Spec.Strategy.MaxUnavailable = ptr.To[int32](1)
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.Strategy.MaxSurge).To(HaveValue(BeEquivalentTo(0)))
+			Expect(obj.Spec.Strategy.MaxUnavailable).To(HaveValue(BeEquivalentTo(1)))
+		})
+	})
+
+	Context("When creating or updating StackitMachinePool under Validating Webhook", func() {
+		It("Should admit a valid pool", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny a strategy that can neither create nor delete servers", func() {
+			obj.Spec.Strategy = infrastructurev1alpha1.MachinePoolStrategy{
+				MaxSurge:       ptr.To[int32](0),
+				MaxUnavailable: ptr.To[int32](0),
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.strategy.maxSurge")))
+		})
+
+		It("Should validate the image selector", func() {
+			obj.Spec.Template.Image = infrastructurev1alpha1.ImageSpec{Selector: &infrastructurev1alpha1.ImageSelector{}}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.template.image.selector: Required value")))
+		})
+
+		It("Should admit template changes", func() {
+			obj.Spec.Template.MachineType = "c1.4"
+			obj.Spec.Template.Image = infrastructurev1alpha1.ImageSpec{Selector: &infrastructurev1alpha1.ImageSelector{
+				NamePattern:            "ubuntu-22.04-kube-*",
+				MatchKubernetesVersion: true,
+			}}
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny invalid provider IDs", func() {
+			obj.Spec.ProviderIDList = []string{"aws:///i-1234"}
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.providerIDList[0]")))
+		})
+	})
+})
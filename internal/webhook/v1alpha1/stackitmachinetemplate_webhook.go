@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var stackitmachinetemplatelog = logf.Log.WithName("stackitmachinetemplate-resource")
+
+// SetupStackitMachineTemplateWebhookWithManager registers the webhook for StackitMachineTemplate in the manager.
+func SetupStackitMachineTemplateWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&infrastructurev1alpha1.StackitMachineTemplate{}).
+		WithValidator(&StackitMachineTemplateCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1alpha1-stackitmachinetemplate,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=stackitmachinetemplates,verbs=create;update,versions=v1alpha1,name=vstackitmachinetemplate-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// StackitMachineTemplateCustomValidator validates the StackitMachineTemplate
+// resource when it is created or updated.
+type StackitMachineTemplateCustomValidator struct{}
+
+var _ webhook.CustomValidator = &StackitMachineTemplateCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type StackitMachineTemplate.
+func (v *StackitMachineTemplateCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	template, ok := obj.(*infrastructurev1alpha1.StackitMachineTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected a StackitMachineTemplate object but got %T", obj)
+	}
+	stackitmachinetemplatelog.Info("Validation for StackitMachineTemplate upon creation", "name", template.GetName())
+
+	return nil, toInvalid("StackitMachineTemplate", template.Name,
+		validateStackitMachineSpec(&template.Spec.Template.Spec, field.NewPath("spec", "template", "spec")))
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type StackitMachineTemplate.
+// Cluster API expects templates to be immutable and rotates them instead.
+func (v *StackitMachineTemplateCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	template, ok := newObj.(*infrastructurev1alpha1.StackitMachineTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected a StackitMachineTemplate object for the newObj but got %T", newObj)
+	}
+	old, ok := oldObj.(*infrastructurev1alpha1.StackitMachineTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected a StackitMachineTemplate object for the oldObj but got %T", oldObj)
+	}
+	stackitmachinetemplatelog.Info("Validation for StackitMachineTemplate upon update", "name", template.GetName())
+
+	var allErrs field.ErrorList
+	if !equality.Semantic.DeepEqual(old.Spec.Template.Spec, template.Spec.Template.Spec) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "template", "spec"), "field is immutable"))
+	}
+	return nil, toInvalid("StackitMachineTemplate", template.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type StackitMachineTemplate.
+func (v *StackitMachineTemplateCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+)
+
+var _ = Describe("StackitMachineTemplate Webhook", func() {
+	var (
+		obj       *infrastructurev1alpha1.StackitMachineTemplate
+		oldObj    *infrastructurev1alpha1.StackitMachineTemplate
+		validator StackitMachineTemplateCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &infrastructurev1alpha1.StackitMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "webhook-test", Namespace: "default"},
+			Spec: infrastructurev1alpha1.StackitMachineTemplateSpec{
+				Template: infrastructurev1alpha1.StackitMachineTemplateResource{
+					Spec: infrastructurev1alpha1.StackitMachineSpec{
+						MachineType: "c1.2",
+						Image:       infrastructurev1alpha1.ImageSpec{Name: ptr.To("ubuntu-22.04")},
+					},
+				},
+			},
+		}
+		oldObj = obj.DeepCopy()
+		validator = StackitMachineTemplateCustomValidator{}
+	})
+
+	Context("When creating or updating StackitMachineTemplate under Validating Webhook", func() {
+		It("Should validate the machine spec of the template", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+
+			obj.Spec.Template.Spec.ProviderID = ptr.To("aws:///i-123")
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.template.spec.providerID")))
+		})
+
+		It("Should deny changes of the template spec", func() {
+			obj.Spec.Template.Spec.MachineType = "c1.4"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.template.spec: Forbidden: field is immutable")))
+		})
+
+		It("Should admit changes of the template metadata", func() {
+			obj.Spec.Template.ObjectMeta.Labels = map[string]string{"role": "worker"}
+			obj.Labels = map[string]string{"team": "a"}
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,171 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/util/version"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+)
+
+// maintenanceTimeLayout is the format of the times of day of the maintenance
+// window.
+const maintenanceTimeLayout = "15:04:05Z07:00"
+
+// nolint:unused
+// log is for logging in this package.
+var stackitmanagedcontrolplanelog = logf.Log.WithName("stackitmanagedcontrolplane-resource")
+
+// SetupStackitManagedControlPlaneWebhookWithManager registers the webhook for StackitManagedControlPlane in the manager.
+func SetupStackitManagedControlPlaneWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&infrastructurev1alpha1.StackitManagedControlPlane{}).
+		WithValidator(&StackitManagedControlPlaneCustomValidator{}).
+		WithDefaulter(&StackitManagedControlPlaneCustomDefaulter{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-infrastructure-cluster-x-k8s-io-v1alpha1-stackitmanagedcontrolplane,mutating=true,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=stackitmanagedcontrolplanes,verbs=create;update,versions=v1alpha1,name=mstackitmanagedcontrolplane-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// StackitManagedControlPlaneCustomDefaulter sets default values on the
+// StackitManagedControlPlane resource when it is created or updated.
+type StackitManagedControlPlaneCustomDefaulter struct{}
+
+var _ webhook.CustomDefaulter = &StackitManagedControlPlaneCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind StackitManagedControlPlane.
+func (d *StackitManagedControlPlaneCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	controlPlane, ok := obj.(*infrastructurev1alpha1.StackitManagedControlPlane)
+	if !ok {
+		return fmt.Errorf("expected a StackitManagedControlPlane object but got %T", obj)
+	}
+	stackitmanagedcontrolplanelog.Info("Defaulting for StackitManagedControlPlane", "name", controlPlane.GetName())
+
+	spec := &controlPlane.Spec
+	if spec.Region == "" {
+		spec.Region = infrastructurev1alpha1.DefaultRegion
+	}
+	if spec.Name == "" && isSKEName(controlPlane.Name, infrastructurev1alpha1.MaxSKEClusterNameLength) {
+		spec.Name = controlPlane.Name
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1alpha1-stackitmanagedcontrolplane,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=stackitmanagedcontrolplanes,verbs=create;update,versions=v1alpha1,name=vstackitmanagedcontrolplane-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// StackitManagedControlPlaneCustomValidator validates the
+// StackitManagedControlPlane resource when it is created or updated.
+type StackitManagedControlPlaneCustomValidator struct{}
+
+var _ webhook.CustomValidator = &StackitManagedControlPlaneCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type StackitManagedControlPlane.
+func (v *StackitManagedControlPlaneCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	controlPlane, ok := obj.(*infrastructurev1alpha1.StackitManagedControlPlane)
+	if !ok {
+		return nil, fmt.Errorf("expected a StackitManagedControlPlane object but got %T", obj)
+	}
+	stackitmanagedcontrolplanelog.Info("Validation for StackitManagedControlPlane upon creation", "name", controlPlane.GetName())
+
+	return nil, toInvalid("StackitManagedControlPlane", controlPlane.Name,
+		validateStackitManagedControlPlaneSpec(&controlPlane.Spec, field.NewPath("spec")))
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type StackitManagedControlPlane.
+// SKE clusters cannot be renamed, moved or downgraded.
+func (v *StackitManagedControlPlaneCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	controlPlane, ok := newObj.(*infrastructurev1alpha1.StackitManagedControlPlane)
+	if !ok {
+		return nil, fmt.Errorf("expected a StackitManagedControlPlane object for the newObj but got %T", newObj)
+	}
+	old, ok := oldObj.(*infrastructurev1alpha1.StackitManagedControlPlane)
+	if !ok {
+		return nil, fmt.Errorf("expected a StackitManagedControlPlane object for the oldObj but got %T", oldObj)
+	}
+	stackitmanagedcontrolplanelog.Info("Validation for StackitManagedControlPlane upon update", "name", controlPlane.GetName())
+
+	path := field.NewPath("spec")
+	allErrs := validateStackitManagedControlPlaneSpec(&controlPlane.Spec, path)
+	allErrs = append(allErrs, immutable(path.Child("projectID"), old.Spec.ProjectID, controlPlane.Spec.ProjectID)...)
+	allErrs = append(allErrs, immutable(path.Child("region"), old.Spec.Region, controlPlane.Spec.Region)...)
+	allErrs = append(allErrs, immutable(path.Child("name"), old.Spec.Name, controlPlane.Spec.Name)...)
+	allErrs = append(allErrs, immutable(path.Child("networkID"), old.Spec.NetworkID, controlPlane.Spec.NetworkID)...)
+	oldVersion, oldErr := parseKubernetesVersion(old.Spec.Version)
+	newVersion, newErr := parseKubernetesVersion(controlPlane.Spec.Version)
+	if oldErr == nil && newErr == nil && newVersion.LessThan(oldVersion) {
+		allErrs = append(allErrs, field.Invalid(path.Child("version"), controlPlane.Spec.Version,
+			"must not be older than "+old.Spec.Version))
+	}
+	return nil, toInvalid("StackitManagedControlPlane", controlPlane.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type StackitManagedControlPlane.
+func (v *StackitManagedControlPlaneCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateStackitManagedControlPlaneSpec validates the fields of spec which
+// are not covered by the OpenAPI schema.
+func validateStackitManagedControlPlaneSpec(spec *infrastructurev1alpha1.StackitManagedControlPlaneSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.Name == "" {
+		allErrs = append(allErrs, field.Required(path.Child("name"),
+			fmt.Sprintf("must be set if metadata.name is not a DNS label of at most %d characters",
+				infrastructurev1alpha1.MaxSKEClusterNameLength)))
+	}
+	if _, err := parseKubernetesVersion(spec.Version); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("version"), spec.Version,
+			"must be a Kubernetes version, for example v1.31.4"))
+	}
+	if m := spec.Maintenance; m != nil {
+		allErrs = append(allErrs, validateMaintenanceTime(path.Child("maintenance", "start"), m.Start)...)
+		allErrs = append(allErrs, validateMaintenanceTime(path.Child("maintenance", "end"), m.End)...)
+	}
+	return allErrs
+}
+
+// validateMaintenanceTime validates that value is a time of day with time
+// zone.
+func validateMaintenanceTime(path *field.Path, value string) field.ErrorList {
+	if _, err := time.Parse(maintenanceTimeLayout, value); err != nil {
+		return field.ErrorList{field.Invalid(path, value, "must be a time of day with time zone, for example 03:00:00+02:00")}
+	}
+	return nil
+}
+
+// parseKubernetesVersion parses a Kubernetes version with or without the
+// leading "v".
+func parseKubernetesVersion(v string) (*version.Version, error) {
+	return version.ParseSemantic(strings.TrimPrefix(v, "v"))
+}
+
+// isSKEName returns true if name is a valid name of an SKE cluster or node
+// pool of at most maxLength characters.
+func isSKEName(name string, maxLength int) bool {
+	return len(name) <= maxLength && len(validation.IsDNS1123Label(name)) == 0
+}
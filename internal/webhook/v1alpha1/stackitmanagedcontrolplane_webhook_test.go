@@ -0,0 +1,107 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+)
+
+var _ = Describe("StackitManagedControlPlane Webhook", func() {
+	var (
+		obj       *infrastructurev1alpha1.StackitManagedControlPlane
+		oldObj    *infrastructurev1alpha1.StackitManagedControlPlane
+		validator StackitManagedControlPlaneCustomValidator
+		defaulter StackitManagedControlPlaneCustomDefaulter
+	)
+
+	BeforeEach(func() {
+		obj = &infrastructurev1alpha1.StackitManagedControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "webhook-test", Namespace: "default"},
+			Spec: infrastructurev1alpha1.StackitManagedControlPlaneSpec{
+				ProjectID: "11111111-1111-1111-1111-111111111111",
+				Region:    "eu01",
+				Name:      "test",
+				Version:   "v1.31.4",
+			},
+		}
+		oldObj = obj.DeepCopy()
+		validator = StackitManagedControlPlaneCustomValidator{}
+		defaulter = StackitManagedControlPlaneCustomDefaulter{}
+	})
+
+	Context("When creating StackitManagedControlPlane under Defaulting Webhook", func() {
+		It("Should default the region and the SKE cluster name", func() {
+			obj.Name = "short"
+			obj.Spec.Region = ""
+			obj.Spec.Name = ""
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.Region).To(Equal(infrastructurev1alpha1.DefaultRegion))
+			Expect(obj.Spec.Name).To(Equal("short"))
+		})
+
+		It("Should not default the SKE cluster name to a name SKE does not accept", func() {
+			obj.Spec.Name = ""
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.Name).To(BeEmpty())
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.name: Required value")))
+		})
+	})
+
+	Context("When creating or updating StackitManagedControlPlane under Validating Webhook", func() {
+		It("Should admit a valid control plane", func() {
+			obj.Spec.Maintenance = &infrastructurev1alpha1.ManagedMaintenanceSpec{
+				AutoUpdateKubernetesVersion: true,
+				Start:                       "01:00:00Z",
+				End:                         "03:00:00+02:00",
+			}
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny invalid versions and maintenance windows", func() {
+			obj.Spec.Version = "latest"
+			obj.Spec.Maintenance = &infrastructurev1alpha1.ManagedMaintenanceSpec{Start: "1am", End: "03:00:00Z"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.version")))
+			Expect(err).To(MatchError(ContainSubstring("spec.maintenance.start")))
+			Expect(err).NotTo(MatchError(ContainSubstring("spec.maintenance.end")))
+		})
+
+		It("Should admit Kubernetes upgrades", func() {
+			obj.Spec.Version = "v1.32.0"
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny Kubernetes downgrades", func() {
+			obj.Spec.Version = "v1.30.8"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.version")))
+		})
+
+		It("Should deny moving or renaming the SKE cluster", func() {
+			obj.Spec.Name = "renamed"
+			obj.Spec.Region = "eu02"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.name")))
+			Expect(err).To(MatchError(ContainSubstring("spec.region")))
+		})
+	})
+})
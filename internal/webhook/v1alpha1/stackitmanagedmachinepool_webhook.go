@@ -0,0 +1,143 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var stackitmanagedmachinepoollog = logf.Log.WithName("stackitmanagedmachinepool-resource")
+
+// SetupStackitManagedMachinePoolWebhookWithManager registers the webhook for StackitManagedMachinePool in the manager.
+func SetupStackitManagedMachinePoolWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&infrastructurev1alpha1.StackitManagedMachinePool{}).
+		WithValidator(&StackitManagedMachinePoolCustomValidator{}).
+		WithDefaulter(&StackitManagedMachinePoolCustomDefaulter{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-infrastructure-cluster-x-k8s-io-v1alpha1-stackitmanagedmachinepool,mutating=true,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=stackitmanagedmachinepools,verbs=create;update,versions=v1alpha1,name=mstackitmanagedmachinepool-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// StackitManagedMachinePoolCustomDefaulter sets default values on the
+// StackitManagedMachinePool resource when it is created or updated.
+type StackitManagedMachinePoolCustomDefaulter struct{}
+
+var _ webhook.CustomDefaulter = &StackitManagedMachinePoolCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind StackitManagedMachinePool.
+func (d *StackitManagedMachinePoolCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	pool, ok := obj.(*infrastructurev1alpha1.StackitManagedMachinePool)
+	if !ok {
+		return fmt.Errorf("expected a StackitManagedMachinePool object but got %T", obj)
+	}
+	stackitmanagedmachinepoollog.Info("Defaulting for StackitManagedMachinePool", "name", pool.GetName())
+
+	spec := &pool.Spec
+	if spec.Name == "" && isSKEName(pool.Name, infrastructurev1alpha1.MaxSKENodePoolNameLength) {
+		spec.Name = pool.Name
+	}
+	if spec.Image.Name == "" {
+		spec.Image.Name = infrastructurev1alpha1.DefaultManagedMachineImage
+	}
+	if spec.BootVolume.Size == 0 {
+		spec.BootVolume.Size = infrastructurev1alpha1.DefaultBootVolumeSize
+	}
+	if spec.MaxSurge == nil {
+		spec.MaxSurge = ptr.To[int32](infrastructurev1alpha1.DefaultMaxSurge)
+	}
+	if spec.MaxUnavailable == nil {
+		spec.MaxUnavailable = ptr.To[int32](0)
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1alpha1-stackitmanagedmachinepool,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=stackitmanagedmachinepools,verbs=create;update,versions=v1alpha1,name=vstackitmanagedmachinepool-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// StackitManagedMachinePoolCustomValidator validates the
+// StackitManagedMachinePool resource when it is created or updated.
+type StackitManagedMachinePoolCustomValidator struct{}
+
+var _ webhook.CustomValidator = &StackitManagedMachinePoolCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type StackitManagedMachinePool.
+func (v *StackitManagedMachinePoolCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	pool, ok := obj.(*infrastructurev1alpha1.StackitManagedMachinePool)
+	if !ok {
+		return nil, fmt.Errorf("expected a StackitManagedMachinePool object but got %T", obj)
+	}
+	stackitmanagedmachinepoollog.Info("Validation for StackitManagedMachinePool upon creation", "name", pool.GetName())
+
+	return nil, toInvalid("StackitManagedMachinePool", pool.Name,
+		validateStackitManagedMachinePoolSpec(&pool.Spec, field.NewPath("spec")))
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type StackitManagedMachinePool.
+// Node pools cannot be renamed; SKE replaces the nodes of other changes.
+func (v *StackitManagedMachinePoolCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	pool, ok := newObj.(*infrastructurev1alpha1.StackitManagedMachinePool)
+	if !ok {
+		return nil, fmt.Errorf("expected a StackitManagedMachinePool object for the newObj but got %T", newObj)
+	}
+	old, ok := oldObj.(*infrastructurev1alpha1.StackitManagedMachinePool)
+	if !ok {
+		return nil, fmt.Errorf("expected a StackitManagedMachinePool object for the oldObj but got %T", oldObj)
+	}
+	stackitmanagedmachinepoollog.Info("Validation for StackitManagedMachinePool upon update", "name", pool.GetName())
+
+	path := field.NewPath("spec")
+	allErrs := validateStackitManagedMachinePoolSpec(&pool.Spec, path)
+	allErrs = append(allErrs, immutable(path.Child("name"), old.Spec.Name, pool.Spec.Name)...)
+	return nil, toInvalid("StackitManagedMachinePool", pool.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type StackitManagedMachinePool.
+func (v *StackitManagedMachinePoolCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateStackitManagedMachinePoolSpec validates the fields of spec which
+// are not covered by the OpenAPI schema.
+func validateStackitManagedMachinePoolSpec(spec *infrastructurev1alpha1.StackitManagedMachinePoolSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.Name == "" {
+		allErrs = append(allErrs, field.Required(path.Child("name"),
+			fmt.Sprintf("must be set if metadata.name is not a DNS label of at most %d characters",
+				infrastructurev1alpha1.MaxSKENodePoolNameLength)))
+	}
+	if scaling := spec.Scaling; scaling != nil && scaling.MaxSize < scaling.MinSize {
+		allErrs = append(allErrs, field.Invalid(path.Child("scaling", "maxSize"), scaling.MaxSize,
+			"must not be less than minSize"))
+	}
+	// Nodes could neither be created nor deleted while replacing them.
+	if ptr.Deref(spec.MaxSurge, infrastructurev1alpha1.DefaultMaxSurge) == 0 && ptr.Deref(spec.MaxUnavailable, 0) == 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("maxSurge"), 0, "must not be 0 if maxUnavailable is 0"))
+	}
+	return allErrs
+}
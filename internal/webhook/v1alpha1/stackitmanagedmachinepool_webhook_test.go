@@ -0,0 +1,99 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	infrastructurev1alpha1 "github.com/aniruddha2000/cluster-api-provider-stackit/api/v1alpha1"
+)
+
+var _ = Describe("StackitManagedMachinePool Webhook", func() {
+	var (
+		obj       *infrastructurev1alpha1.StackitManagedMachinePool
+		oldObj    *infrastructurev1alpha1.StackitManagedMachinePool
+		validator StackitManagedMachinePoolCustomValidator
+		defaulter StackitManagedMachinePoolCustomDefaulter
+	)
+
+	BeforeEach(func() {
+		obj = &infrastructurev1alpha1.StackitManagedMachinePool{
+			ObjectMeta: metav1.ObjectMeta{Name: "webhook-test", Namespace: "default"},
+			Spec: infrastructurev1alpha1.StackitManagedMachinePoolSpec{
+				Name:        "webhook-test",
+				MachineType: "c1.2",
+			},
+		}
+		oldObj = obj.DeepCopy()
+		validator = StackitManagedMachinePoolCustomValidator{}
+		defaulter = StackitManagedMachinePoolCustomDefaulter{}
+	})
+
+	Context("When creating StackitManagedMachinePool under Defaulting Webhook", func() {
+		It("Should default the node pool name, the image, the boot volume and the strategy", func() {
+			obj.Spec.Name = ""
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.Name).To(Equal("webhook-test"))
+			Expect(obj.Spec.Image.Name).To(Equal(infrastructurev1alpha1.DefaultManagedMachineImage))
+			Expect(obj.Spec.BootVolume.Size).To(BeEquivalentTo(infrastructurev1alpha1.DefaultBootVolumeSize))
+			Expect(obj.Spec.MaxSurge).To(HaveValue(BeEquivalentTo(infrastructurev1alpha1.DefaultMaxSurge)))
+			Expect(obj.Spec.MaxUnavailable).To(HaveValue(BeEquivalentTo(0)))
+		})
+
+		It("Should not default the node pool name to a name SKE does not accept", func() {
+			obj.Name = "webhook-test-pool-0"
+			obj.Spec.Name = ""
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.Name).To(BeEmpty())
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.name: Required value")))
+		})
+	})
+
+	Context("When creating or updating StackitManagedMachinePool under Validating Webhook", func() {
+		It("Should admit a valid pool", func() {
+			obj.Spec.Scaling = &infrastructurev1alpha1.ManagedMachinePoolScaling{MinSize: 1, MaxSize: 3}
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny a maximum size below the minimum size", func() {
+			obj.Spec.Scaling = &infrastructurev1alpha1.ManagedMachinePoolScaling{MinSize: 3, MaxSize: 1}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.scaling.maxSize")))
+		})
+
+		It("Should deny a strategy that can neither create nor delete nodes", func() {
+			obj.Spec.MaxSurge = ptr.To[int32](0)
+			obj.Spec.MaxUnavailable = ptr.To[int32](0)
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.maxSurge")))
+		})
+
+		It("Should deny renaming the node pool", func() {
+			obj.Spec.Name = "renamed"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.name")))
+
+			obj.Spec.Name = oldObj.Spec.Name
+			obj.Spec.MachineType = "c1.4"
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+	})
+})
@@ -40,6 +40,10 @@ var (
 	// projectImage is the name of the image which will be build and loaded
 	// with the code source changes to be tested.
 	projectImage = "example.com/cluster-api-provider-stackit:v0.0.1"
+
+	// stackitAPIImage is the name of the image of the fake STACKIT API,
+	// which the manager is deployed against.
+	stackitAPIImage = "example.com/cluster-api-provider-stackit-stackitapi:v0.0.1"
 )
 
 // TestE2E runs the end-to-end (e2e) test suite for the project. These tests execute in an isolated,
@@ -64,6 +68,15 @@ var _ = BeforeSuite(func() {
 	err = utils.LoadImageToKindClusterWithName(projectImage)
 	ExpectWithOffset(1, err).NotTo(HaveOccurred(), "Failed to load the manager(Operator) image into Kind")
 
+	By("building the fake STACKIT API image")
+	cmd = exec.Command("make", "docker-build-stackitapi", fmt.Sprintf("STACKITAPI_IMG=%s", stackitAPIImage))
+	_, err = utils.Run(cmd)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred(), "Failed to build the fake STACKIT API image")
+
+	By("loading the fake STACKIT API image on Kind")
+	err = utils.LoadImageToKindClusterWithName(stackitAPIImage)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred(), "Failed to load the fake STACKIT API image into Kind")
+
 	// The tests-e2e are intended to run on a temporary cluster that is created and destroyed for testing.
 	// To prevent errors when tests run in environments with CertManager already installed,
 	// we check for its presence before execution.
@@ -22,6 +22,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -42,6 +43,17 @@ const metricsServiceName = "cluster-api-provider-stackit-controller-manager-metr
 // metricsRoleBindingName is the name of the RBAC that will be created to allow get the metrics data
 const metricsRoleBindingName = "cluster-api-provider-stackit-metrics-binding"
 
+// capiCRDs are the Cluster API CRDs the manager watches, relative to the
+// project directory. Cluster API itself is not deployed.
+const capiCRDs = "internal/controller/testdata/crd"
+
+// lifecycleNamespace and lifecycleClusterName are the namespace and name of
+// the Cluster and StackitCluster created against the fake STACKIT API.
+const (
+	lifecycleNamespace   = "default"
+	lifecycleClusterName = "e2e-lifecycle"
+)
+
 var _ = Describe("Manager", Ordered, func() {
 	var controllerPodName string
 
@@ -65,8 +77,14 @@ var _ = Describe("Manager", Ordered, func() {
 		_, err = utils.Run(cmd)
 		Expect(err).NotTo(HaveOccurred(), "Failed to install CRDs")
 
-		By("deploying the controller-manager")
-		cmd = exec.Command("make", "deploy", fmt.Sprintf("IMG=%s", projectImage))
+		By("installing the Cluster API CRDs")
+		cmd = exec.Command("kubectl", "apply", "-f", capiCRDs)
+		_, err = utils.Run(cmd)
+		Expect(err).NotTo(HaveOccurred(), "Failed to install the Cluster API CRDs")
+
+		By("deploying the controller-manager with the fake STACKIT API")
+		cmd = exec.Command("make", "deploy-e2e", fmt.Sprintf("IMG=%s", projectImage),
+			fmt.Sprintf("STACKITAPI_IMG=%s", stackitAPIImage))
 		_, err = utils.Run(cmd)
 		Expect(err).NotTo(HaveOccurred(), "Failed to deploy the controller-manager")
 	})
@@ -78,14 +96,23 @@ var _ = Describe("Manager", Ordered, func() {
 		cmd := exec.Command("kubectl", "delete", "pod", "curl-metrics", "-n", namespace)
 		_, _ = utils.Run(cmd)
 
+		By("cleaning up the StackitCluster")
+		cmd = exec.Command("kubectl", "delete", "stackitcluster,cluster", lifecycleClusterName,
+			"-n", lifecycleNamespace, "--ignore-not-found", "--timeout=1m")
+		_, _ = utils.Run(cmd)
+
 		By("undeploying the controller-manager")
-		cmd = exec.Command("make", "undeploy")
+		cmd = exec.Command("make", "undeploy-e2e")
 		_, _ = utils.Run(cmd)
 
 		By("uninstalling CRDs")
 		cmd = exec.Command("make", "uninstall")
 		_, _ = utils.Run(cmd)
 
+		By("uninstalling the Cluster API CRDs")
+		cmd = exec.Command("kubectl", "delete", "--ignore-not-found", "-f", capiCRDs)
+		_, _ = utils.Run(cmd)
+
 		By("removing manager namespace")
 		cmd = exec.Command("kubectl", "delete", "ns", namespace)
 		_, _ = utils.Run(cmd)
@@ -256,8 +283,121 @@ var _ = Describe("Manager", Ordered, func() {
 			))
 		})
 
+		It("should provisioned cert-manager", func() {
+			By("validating that cert-manager has the certificate Secret")
+			verifyCertManager := func(g Gomega) {
+				cmd := exec.Command("kubectl", "get", "secrets", "webhook-server-cert", "-n", namespace)
+				_, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			Eventually(verifyCertManager).Should(Succeed())
+		})
+
+		It("should have CA injection for mutating webhooks", func() {
+			By("checking CA injection for mutating webhooks")
+			verifyCAInjection := func(g Gomega) {
+				cmd := exec.Command("kubectl", "get",
+					"mutatingwebhookconfigurations.admissionregistration.k8s.io",
+					"cluster-api-provider-stackit-mutating-webhook-configuration",
+					"-o", "go-template={{ range .webhooks }}{{ .clientConfig.caBundle }}{{ end }}")
+				mwhOutput, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(len(mwhOutput)).To(BeNumerically(">", 10))
+			}
+			Eventually(verifyCAInjection).Should(Succeed())
+		})
+
+		It("should have CA injection for validating webhooks", func() {
+			By("checking CA injection for validating webhooks")
+			verifyCAInjection := func(g Gomega) {
+				cmd := exec.Command("kubectl", "get",
+					"validatingwebhookconfigurations.admissionregistration.k8s.io",
+					"cluster-api-provider-stackit-validating-webhook-configuration",
+					"-o", "go-template={{ range .webhooks }}{{ .clientConfig.caBundle }}{{ end }}")
+				vwhOutput, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(len(vwhOutput)).To(BeNumerically(">", 10))
+			}
+			Eventually(verifyCAInjection).Should(Succeed())
+		})
+
 		// +kubebuilder:scaffold:e2e-webhooks-checks
 
+		It("should create and delete the infrastructure of a StackitCluster", func() {
+			By("creating the Cluster")
+			cmd := exec.Command("kubectl", "apply", "-f", "-")
+			cmd.Stdin = strings.NewReader(fmt.Sprintf(`apiVersion: cluster.x-k8s.io/v1beta1
+kind: Cluster
+metadata:
+  name: %[1]s
+  namespace: %[2]s
+spec:
+  infrastructureRef:
+    apiVersion: infrastructure.cluster.x-k8s.io/v1alpha1
+    kind: StackitCluster
+    name: %[1]s
+`, lifecycleClusterName, lifecycleNamespace))
+			_, err := utils.Run(cmd)
+			Expect(err).NotTo(HaveOccurred(), "Failed to create the Cluster")
+
+			cmd = exec.Command("kubectl", "get", "cluster", lifecycleClusterName,
+				"-n", lifecycleNamespace, "-o", "jsonpath={.metadata.uid}")
+			clusterUID, err := utils.Run(cmd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterUID).NotTo(BeEmpty())
+
+			By("creating the StackitCluster owned by the Cluster")
+			cmd = exec.Command("kubectl", "apply", "-f", "-")
+			cmd.Stdin = strings.NewReader(fmt.Sprintf(`apiVersion: infrastructure.cluster.x-k8s.io/v1alpha1
+kind: StackitCluster
+metadata:
+  name: %[1]s
+  namespace: %[2]s
+  ownerReferences:
+  - apiVersion: cluster.x-k8s.io/v1beta1
+    kind: Cluster
+    name: %[1]s
+    uid: %[3]s
+spec:
+  projectID: 00000000-0000-0000-0000-000000000000
+  region: eu01
+  network:
+    cidr: 10.0.0.0/24
+`, lifecycleClusterName, lifecycleNamespace, clusterUID))
+			_, err = utils.Run(cmd)
+			Expect(err).NotTo(HaveOccurred(), "Failed to create the StackitCluster")
+
+			By("waiting for the infrastructure to be ready")
+			verifyReady := func(g Gomega) {
+				cmd := exec.Command("kubectl", "get", "stackitcluster", lifecycleClusterName,
+					"-n", lifecycleNamespace, "-o", "jsonpath={.status.ready}/{.status.network.id}")
+				output, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(output).To(MatchRegexp(`^true/.+`), "StackitCluster is not ready")
+			}
+			Eventually(verifyReady).Should(Succeed())
+
+			By("deleting the StackitCluster")
+			cmd = exec.Command("kubectl", "delete", "stackitcluster", lifecycleClusterName,
+				"-n", lifecycleNamespace, "--wait=false")
+			_, err = utils.Run(cmd)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("waiting for the infrastructure to be deleted and the finalizer to be removed")
+			verifyDeleted := func(g Gomega) {
+				cmd := exec.Command("kubectl", "get", "stackitcluster", lifecycleClusterName,
+					"-n", lifecycleNamespace, "--ignore-not-found", "-o", "name")
+				output, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(output).To(BeEmpty(), "StackitCluster still exists")
+			}
+			Eventually(verifyDeleted).Should(Succeed())
+
+			cmd = exec.Command("kubectl", "delete", "cluster", lifecycleClusterName, "-n", lifecycleNamespace)
+			_, err = utils.Run(cmd)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
 		// TODO: Customize the e2e test suite with scenarios specific to your project.
 		// Consider applying sample/CR(s) and check their status and/or verifying
 		// the reconciliation by using the metrics, i.e.:
@@ -0,0 +1,222 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package s3api provides a local stand-in for the S3 API of STACKIT Object
+// Storage. It keeps the objects in memory and serves path-style requests
+// for putting, getting and deleting objects, so that the controllers can
+// offload bootstrap data without STACKIT credentials.
+//
+// Requests must be authenticated with the access key of the server, either
+// by an Authorization header or by a presigned URL. Presigned URLs expire,
+// but signatures are not verified.
+package s3api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const amzDateFormat = "20060102T150405Z"
+
+// Options configures the behavior of a Server.
+type Options struct {
+	// AccessKeyID is the access key requests must be signed with.
+	AccessKeyID string
+}
+
+// Server is a fake S3 API server.
+type Server struct {
+	opts Options
+	mux  *http.ServeMux
+	http *httptest.Server
+
+	mu       sync.Mutex
+	now      func() time.Time
+	requests []string
+	buckets  map[string]map[string][]byte
+}
+
+// NewServer starts a Server. It must be closed with Close.
+func NewServer(opts Options) *Server {
+	s := NewUnstartedServer(opts)
+	s.http = httptest.NewServer(s)
+	return s
+}
+
+// NewUnstartedServer returns a Server that is not listening. It can be served
+// with any http.Server as it implements http.Handler.
+func NewUnstartedServer(opts Options) *Server {
+	s := &Server{
+		opts:    opts,
+		mux:     http.NewServeMux(),
+		now:     time.Now,
+		buckets: map[string]map[string][]byte{},
+	}
+	s.mux.HandleFunc("PUT /{bucket}/{key...}", s.putObject)
+	s.mux.HandleFunc("GET /{bucket}/{key...}", s.getObject)
+	s.mux.HandleFunc("DELETE /{bucket}/{key...}", s.deleteObject)
+	return s
+}
+
+// URL returns the base URL of a started Server.
+func (s *Server) URL() string {
+	return s.http.URL
+}
+
+// Close shuts down a started Server.
+func (s *Server) Close() {
+	s.http.Close()
+}
+
+// CreateBucket creates an empty bucket if it does not exist yet.
+func (s *Server) CreateBucket(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.buckets[name]; !ok {
+		s.buckets[name] = map[string][]byte{}
+	}
+}
+
+// Object returns the content of an object and whether it exists.
+func (s *Server) Object(bucket, key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.buckets[bucket][key]
+	return data, ok
+}
+
+// Objects returns the sorted keys of the objects in a bucket.
+func (s *Server) Objects(bucket string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Sorted(maps.Keys(s.buckets[bucket]))
+}
+
+// Requests returns the "METHOD path" of all requests served so far.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	s.mu.Unlock()
+
+	if code, message := s.authenticate(r); code != "" {
+		writeError(w, http.StatusForbidden, code, message)
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+// authenticate checks the access key of r and the expiry of presigned URLs.
+// It returns the code and message of the error response if r is rejected.
+func (s *Server) authenticate(r *http.Request) (string, string) {
+	query := r.URL.Query()
+	var credential string
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		_, params, _ := strings.Cut(auth, " ")
+		for param := range strings.SplitSeq(params, ",") {
+			if value, ok := strings.CutPrefix(strings.TrimSpace(param), "Credential="); ok {
+				credential = value
+			}
+		}
+	} else if query.Has("X-Amz-Credential") {
+		credential = query.Get("X-Amz-Credential")
+		date, err := time.Parse(amzDateFormat, query.Get("X-Amz-Date"))
+		if err != nil {
+			return "AuthorizationQueryParametersError", "invalid X-Amz-Date"
+		}
+		expires, err := strconv.Atoi(query.Get("X-Amz-Expires"))
+		if err != nil {
+			return "AuthorizationQueryParametersError", "invalid X-Amz-Expires"
+		}
+		if s.now().After(date.Add(time.Duration(expires) * time.Second)) {
+			return "AccessDenied", "Request has expired"
+		}
+	}
+	if credential == "" {
+		return "AccessDenied", "Anonymous access is not allowed"
+	}
+	if accessKeyID, _, _ := strings.Cut(credential, "/"); accessKeyID != s.opts.AccessKeyID {
+		return "InvalidAccessKeyId", "The access key ID does not exist"
+	}
+	return "", ""
+}
+
+func (s *Server) putObject(w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "IncompleteBody", err.Error())
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	objects, ok := s.buckets[r.PathValue("bucket")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+		return
+	}
+	objects[r.PathValue("key")] = data
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) getObject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	objects, ok := s.buckets[r.PathValue("bucket")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+		return
+	}
+	data, ok := objects[r.PathValue("key")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NoSuchKey", "The specified key does not exist")
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	_, _ = w.Write(data)
+}
+
+func (s *Server) deleteObject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	objects, ok := s.buckets[r.PathValue("bucket")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+		return
+	}
+	delete(objects, r.PathValue("key"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = fmt.Fprintf(w, "%s<Error><Code>%s</Code><Message>%s</Message></Error>", xml.Header, code, message)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud/s3"
+)
+
+func newTestClient(t *testing.T, s *Server, bucket, accessKeyID string) cloud.ObjectStorage {
+	t.Helper()
+	c, err := s3.New(cloud.ObjectStorageScope{
+		Endpoint:    s.URL(),
+		Region:      "eu01",
+		Bucket:      bucket,
+		Credentials: cloud.ObjectStorageCredentials{AccessKeyID: accessKeyID, SecretAccessKey: "secret"},
+	}, s3.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func get(g Gomega, url string) (int, string) {
+	resp, err := http.Get(url)
+	g.Expect(err).NotTo(HaveOccurred())
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(resp.Body)
+	g.Expect(err).NotTo(HaveOccurred())
+	return resp.StatusCode, string(body)
+}
+
+func TestObjects(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	s := NewServer(Options{AccessKeyID: "key"})
+	t.Cleanup(s.Close)
+	s.CreateBucket("bootstrap")
+	c := newTestClient(t, s, "bootstrap", "key")
+
+	g.Expect(c.PutObject(ctx, "default/machine 1.ign", []byte(`{"ignition":{}}`))).To(Succeed())
+	g.Expect(s.Objects("bootstrap")).To(ConsistOf("default/machine 1.ign"))
+
+	url, err := c.PresignGetObject("default/machine 1.ign", time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	status, body := get(g, url)
+	g.Expect(status).To(Equal(http.StatusOK))
+	g.Expect(body).To(Equal(`{"ignition":{}}`))
+
+	status, _ = get(g, s.URL()+"/bootstrap/default/machine%201.ign")
+	g.Expect(status).To(Equal(http.StatusForbidden))
+
+	s.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	status, body = get(g, url)
+	g.Expect(status).To(Equal(http.StatusForbidden))
+	g.Expect(body).To(ContainSubstring("expired"))
+	s.now = time.Now
+
+	g.Expect(c.DeleteObject(ctx, "default/machine 1.ign")).To(Succeed())
+	g.Expect(s.Objects("bootstrap")).To(BeEmpty())
+	g.Expect(c.DeleteObject(ctx, "default/machine 1.ign")).To(Succeed())
+}
+
+func TestErrors(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	s := NewServer(Options{AccessKeyID: "key"})
+	t.Cleanup(s.Close)
+	s.CreateBucket("bootstrap")
+
+	err := newTestClient(t, s, "missing", "key").PutObject(ctx, "object", nil)
+	g.Expect(cloud.IsNotFound(err)).To(BeTrue())
+	g.Expect(err).To(MatchError(ContainSubstring("NoSuchBucket")))
+
+	err = newTestClient(t, s, "bootstrap", "other").PutObject(ctx, "object", nil)
+	g.Expect(err).To(MatchError(ContainSubstring("InvalidAccessKeyId")))
+	g.Expect(s.Objects("bootstrap")).To(BeEmpty())
+}
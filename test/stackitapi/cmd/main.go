@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command stackitapi serves the fake STACKIT APIs of package stackitapi, so
+// that the manager can be deployed against them in the e2e tests.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud/stackit"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/test/stackitapi"
+)
+
+func main() {
+	var bindAddress, images string
+	var opts stackitapi.Options
+	flag.StringVar(&bindAddress, "bind-address", ":8080", "The address the fake STACKIT APIs are served on.")
+	flag.DurationVar(&opts.Latency, "latency", 0, "The latency added to every response.")
+	flag.DurationVar(&opts.ProvisioningDelay, "provisioning-delay", 5*time.Second,
+		"The time resources spend in a transitional state before reaching their final state.")
+	flag.StringVar(&images, "images", "ubuntu-22.04", "Comma-separated names of the images available to all projects.")
+	flag.Parse()
+
+	api := stackitapi.NewUnstartedServer(opts)
+	for _, name := range strings.Split(images, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			api.AddImage(stackit.ImageModel{Name: name})
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	server := &http.Server{Addr: bindAddress, Handler: api, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	log.Printf("serving the fake STACKIT APIs on %s", bindAddress)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatalf("serving the fake STACKIT APIs: %v", err)
+	}
+}
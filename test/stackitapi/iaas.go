@@ -0,0 +1,752 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stackitapi
+
+import (
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud/stackit"
+)
+
+// settle moves resources out of transitional states and removes deleted
+// resources. s.mu must be held.
+func (s *Server) settle() {
+	now := s.now()
+
+	for id, r := range s.servers {
+		switch {
+		case r.deleted(now):
+			s.removeServer(id, r)
+		case r.deleting():
+			r.model.Status = cloud.ServerStatusDeleting
+		case r.failed:
+			r.model.Status = cloud.ServerStatusError
+		case r.ready(now):
+			r.model.Status = cloud.ServerStatusActive
+		default:
+			r.model.Status = cloud.ServerStatusCreating
+		}
+	}
+	for id, r := range s.networks {
+		switch {
+		case r.deleted(now):
+			delete(s.networks, id)
+		case r.deleting():
+			r.model.State = cloud.NetworkStatusDeleting
+		case r.ready(now):
+			r.model.State = cloud.NetworkStatusCreated
+		default:
+			r.model.State = cloud.NetworkStatusCreating
+		}
+	}
+	for id, r := range s.volumes {
+		switch {
+		case r.deleted(now):
+			delete(s.volumes, id)
+		case r.deleting():
+			r.model.Status = cloud.VolumeStatusDeleting
+		case r.model.ServerID != "":
+			r.model.Status = cloud.VolumeStatusAttached
+		case r.ready(now):
+			r.model.Status = cloud.VolumeStatusAvailable
+		default:
+			r.model.Status = cloud.VolumeStatusCreating
+		}
+	}
+	for id, r := range s.securityGroup {
+		if r.deleted(now) {
+			delete(s.securityGroup, id)
+		}
+	}
+	for id, r := range s.publicIPs {
+		if r.deleted(now) {
+			delete(s.publicIPs, id)
+		}
+	}
+	for key, r := range s.loadBalancers {
+		switch {
+		case r.deleted(now):
+			delete(s.loadBalancers, key)
+		case r.deleting():
+			r.model.Status = cloud.LoadBalancerStatusTerminating
+		case r.failed:
+			r.model.Status = cloud.LoadBalancerStatusError
+		case r.ready(now):
+			r.model.Status = cloud.LoadBalancerStatusReady
+			s.assignLoadBalancerAddresses(r)
+		default:
+			r.model.Status = cloud.LoadBalancerStatusPending
+		}
+	}
+	for key, r := range s.skeClusters {
+		if r.deleted(now) {
+			delete(s.skeClusters, key)
+		}
+	}
+}
+
+// removeServer removes a deleted server and releases its attachments.
+func (s *Server) removeServer(id string, r *serverRecord) {
+	for volumeID, v := range s.volumes {
+		if v.model.ServerID != id {
+			continue
+		}
+		v.model.ServerID = ""
+		if volumeID == r.bootVolumeID && r.model.BootVolume != nil && r.model.BootVolume.DeleteOnTermination {
+			delete(s.volumes, volumeID)
+		}
+	}
+	for _, ip := range s.publicIPs {
+		for _, nic := range r.model.NICs {
+			if ip.model.NetworkInterface == nic.NICID {
+				ip.model.NetworkInterface = ""
+			}
+		}
+	}
+	delete(s.servers, id)
+}
+
+// renderServer returns the API representation of a server.
+func (s *Server) renderServer(r *serverRecord) stackit.ServerModel {
+	m := r.model
+	m.Volumes = nil
+	for _, id := range slices.Sorted(maps.Keys(s.volumes)) {
+		if s.volumes[id].model.ServerID == m.ID {
+			m.Volumes = append(m.Volumes, id)
+		}
+	}
+	m.NICs = slices.Clone(r.model.NICs)
+	for i := range m.NICs {
+		for _, ip := range s.publicIPs {
+			if ip.model.NetworkInterface == m.NICs[i].NICID {
+				m.NICs[i].PublicIP = ip.model.IP
+			}
+		}
+	}
+	return m
+}
+
+func (s *Server) createServer(w http.ResponseWriter, r *http.Request) {
+	var in stackit.ServerModel
+	if !decode(w, r, &in) {
+		return
+	}
+	if in.Name == "" || in.MachineType == "" {
+		writeError(w, http.StatusBadRequest, "name and machineType are required")
+		return
+	}
+	imageID := in.ImageID
+	if in.BootVolume != nil && in.BootVolume.Source != nil {
+		imageID = in.BootVolume.Source.ID
+	}
+	if _, ok := s.images[imageID]; !ok {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("image %s not found", imageID))
+		return
+	}
+	for _, id := range in.SecurityGroups {
+		if _, ok := find(s.securityGroup, id, r); !ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("security group %s not found", id))
+			return
+		}
+	}
+
+	for _, id := range in.Volumes {
+		volume, ok := find(s.volumes, id, r)
+		switch {
+		case !ok || volume.deleting():
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("volume %s not found", id))
+			return
+		case volume.model.ServerID != "":
+			writeError(w, http.StatusConflict, fmt.Sprintf("volume %s is attached to another server", id))
+			return
+		case volume.model.Status != cloud.VolumeStatusAvailable:
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("volume %s is not available", id))
+			return
+		case volume.model.AvailabilityZone != in.AvailabilityZone:
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("volume %s is in availability zone %s", id, volume.model.AvailabilityZone))
+			return
+		}
+	}
+
+	if in.AffinityGroup != "" {
+		if _, ok := find(s.serverGroups, in.AffinityGroup, r); !ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("server group %s not found", in.AffinityGroup))
+			return
+		}
+	}
+
+	if len(in.UserData) > cloud.MaxUserDataSize {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("userData exceeds %d bytes", cloud.MaxUserDataSize))
+		return
+	}
+	userData, err := base64.StdEncoding.DecodeString(in.UserData)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "userData must be base64 encoded")
+		return
+	}
+
+	rec := &serverRecord{lifecycle: s.newLifecycle(r), model: in, userData: userData}
+	rec.model.ID = s.newID()
+	rec.model.Networking = nil
+	rec.model.UserData = ""
+	if in.Networking != nil {
+		network, ok := find(s.networks, in.Networking.NetworkID, r)
+		if !ok || network.deleting() {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("network %s not found", in.Networking.NetworkID))
+			return
+		}
+		rec.model.NICs = []stackit.ServerNICModel{{
+			NICID:     s.newID(),
+			NetworkID: network.model.NetworkID,
+			IPv4:      s.allocateIP(network),
+		}}
+	}
+	if in.BootVolume != nil {
+		volume := &volumeRecord{lifecycle: rec.lifecycle, model: stackit.VolumeModel{
+			ID:               s.newID(),
+			Name:             in.Name,
+			Size:             in.BootVolume.Size,
+			PerformanceClass: in.BootVolume.PerformanceClass,
+			AvailabilityZone: in.AvailabilityZone,
+			ServerID:         rec.model.ID,
+			Labels:           maps.Clone(in.Labels),
+		}}
+		s.volumes[volume.model.ID] = volume
+		rec.bootVolumeID = volume.model.ID
+	}
+	for _, id := range in.Volumes {
+		s.volumes[id].model.ServerID = rec.model.ID
+	}
+	rec.model.Volumes = nil
+	s.servers[rec.model.ID] = rec
+	s.settle()
+	writeJSON(w, http.StatusCreated, s.renderServer(rec))
+}
+
+func (s *Server) listServers(w http.ResponseWriter, r *http.Request) {
+	out := stackit.ListResponse[stackit.ServerModel]{Items: []stackit.ServerModel{}}
+	for _, rec := range inScope(s.servers, r) {
+		if matchLabels(rec.model.Labels, r) {
+			out.Items = append(out.Items, s.renderServer(rec))
+		}
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (s *Server) getServer(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.servers, r.PathValue("id"), r)
+	if !ok {
+		notFound(w, "server", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, s.renderServer(rec))
+}
+
+func (s *Server) deleteServer(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.servers, r.PathValue("id"), r)
+	if !ok {
+		notFound(w, "server", r.PathValue("id"))
+		return
+	}
+	s.markDeleted(&rec.lifecycle)
+	s.settle()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) listServerNICs(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.servers, r.PathValue("id"), r)
+	if !ok {
+		notFound(w, "server", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, stackit.ListResponse[stackit.ServerNICModel]{Items: s.renderServer(rec).NICs})
+}
+
+func (s *Server) attachVolume(w http.ResponseWriter, r *http.Request) {
+	server, ok := find(s.servers, r.PathValue("id"), r)
+	if !ok {
+		notFound(w, "server", r.PathValue("id"))
+		return
+	}
+	volume, ok := find(s.volumes, r.PathValue("volume"), r)
+	if !ok {
+		notFound(w, "volume", r.PathValue("volume"))
+		return
+	}
+	if volume.model.ServerID != "" && volume.model.ServerID != server.model.ID {
+		writeError(w, http.StatusConflict, fmt.Sprintf("volume %s is attached to another server", volume.model.ID))
+		return
+	}
+	volume.model.ServerID = server.model.ID
+	s.settle()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) detachVolume(w http.ResponseWriter, r *http.Request) {
+	volume, ok := find(s.volumes, r.PathValue("volume"), r)
+	if !ok || volume.model.ServerID != r.PathValue("id") {
+		notFound(w, "volume attachment", r.PathValue("volume"))
+		return
+	}
+	volume.model.ServerID = ""
+	s.settle()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) associatePublicIP(w http.ResponseWriter, r *http.Request) {
+	server, ok := find(s.servers, r.PathValue("id"), r)
+	if !ok {
+		notFound(w, "server", r.PathValue("id"))
+		return
+	}
+	ip, ok := find(s.publicIPs, r.PathValue("ip"), r)
+	if !ok {
+		notFound(w, "public IP", r.PathValue("ip"))
+		return
+	}
+	if len(server.model.NICs) == 0 {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("server %s has no network interface", server.model.ID))
+		return
+	}
+	nic := server.model.NICs[0].NICID
+	if ip.model.NetworkInterface != "" && ip.model.NetworkInterface != nic {
+		writeError(w, http.StatusConflict, fmt.Sprintf("public IP %s is associated with another server", ip.model.ID))
+		return
+	}
+	ip.model.NetworkInterface = nic
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) disassociatePublicIP(w http.ResponseWriter, r *http.Request) {
+	server, ok := find(s.servers, r.PathValue("id"), r)
+	if !ok {
+		notFound(w, "server", r.PathValue("id"))
+		return
+	}
+	ip, ok := find(s.publicIPs, r.PathValue("ip"), r)
+	if !ok || len(server.model.NICs) == 0 || ip.model.NetworkInterface != server.model.NICs[0].NICID {
+		notFound(w, "public IP association", r.PathValue("ip"))
+		return
+	}
+	ip.model.NetworkInterface = ""
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// allocateIP returns the next free address of a network.
+func (s *Server) allocateIP(network *networkRecord) string {
+	network.nextIP++
+	if len(network.model.Prefixes) == 0 {
+		return fmt.Sprintf("10.255.%d.%d", network.nextIP/250, network.nextIP%250+2)
+	}
+	prefix, err := netip.ParsePrefix(network.model.Prefixes[0])
+	if err != nil {
+		return ""
+	}
+	addr := prefix.Masked().Addr()
+	for range network.nextIP + 9 {
+		addr = addr.Next()
+	}
+	return addr.String()
+}
+
+func (s *Server) createNetwork(w http.ResponseWriter, r *http.Request) {
+	var in stackit.CreateNetworkPayload
+	if !decode(w, r, &in) {
+		return
+	}
+	if in.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+	rec := &networkRecord{lifecycle: s.newLifecycle(r), model: stackit.NetworkModel{
+		NetworkID: s.newID(),
+		Name:      in.Name,
+		Routed:    in.Routed,
+		Labels:    in.Labels,
+	}}
+	if in.AddressFamily != nil && in.AddressFamily.IPv4 != nil {
+		if prefix := in.AddressFamily.IPv4.Prefix; prefix != "" {
+			if _, err := netip.ParsePrefix(prefix); err != nil {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid prefix %q", prefix))
+				return
+			}
+			rec.model.Prefixes = []string{prefix}
+		}
+		rec.model.NameServers = in.AddressFamily.IPv4.NameServers
+	}
+	if in.Routed {
+		s.nextID++
+		rec.model.PublicIP = fmt.Sprintf("203.0.113.%d", s.nextID%250+1)
+	}
+	s.networks[rec.model.NetworkID] = rec
+	s.settle()
+	writeJSON(w, http.StatusAccepted, rec.model)
+}
+
+func (s *Server) listNetworks(w http.ResponseWriter, r *http.Request) {
+	out := stackit.ListResponse[stackit.NetworkModel]{Items: []stackit.NetworkModel{}}
+	for _, rec := range inScope(s.networks, r) {
+		if matchLabels(rec.model.Labels, r) {
+			out.Items = append(out.Items, rec.model)
+		}
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (s *Server) getNetwork(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.networks, r.PathValue("id"), r)
+	if !ok {
+		notFound(w, "network", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, rec.model)
+}
+
+func (s *Server) deleteNetwork(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.networks, r.PathValue("id"), r)
+	if !ok {
+		notFound(w, "network", r.PathValue("id"))
+		return
+	}
+	for _, server := range s.servers {
+		for _, nic := range server.model.NICs {
+			if nic.NetworkID == rec.model.NetworkID {
+				writeError(w, http.StatusConflict, fmt.Sprintf("network %s has attached servers", rec.model.NetworkID))
+				return
+			}
+		}
+	}
+	for _, lb := range s.loadBalancers {
+		for _, n := range lb.model.Networks {
+			if n.NetworkID == rec.model.NetworkID {
+				writeError(w, http.StatusConflict, fmt.Sprintf("network %s is used by a load balancer", rec.model.NetworkID))
+				return
+			}
+		}
+	}
+	s.markDeleted(&rec.lifecycle)
+	s.settle()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) createSecurityGroup(w http.ResponseWriter, r *http.Request) {
+	var in stackit.SecurityGroupModel
+	if !decode(w, r, &in) {
+		return
+	}
+	if in.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+	in.ID = s.newID()
+	in.Rules = nil
+	rec := &securityGroupRecord{lifecycle: s.newLifecycle(r), model: in}
+	s.securityGroup[in.ID] = rec
+	writeJSON(w, http.StatusCreated, rec.model)
+}
+
+func (s *Server) listSecurityGroups(w http.ResponseWriter, r *http.Request) {
+	out := stackit.ListResponse[stackit.SecurityGroupModel]{Items: []stackit.SecurityGroupModel{}}
+	for _, rec := range inScope(s.securityGroup, r) {
+		if matchLabels(rec.model.Labels, r) {
+			out.Items = append(out.Items, rec.model)
+		}
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (s *Server) getSecurityGroup(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.securityGroup, r.PathValue("id"), r)
+	if !ok {
+		notFound(w, "security group", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, rec.model)
+}
+
+func (s *Server) deleteSecurityGroup(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.securityGroup, r.PathValue("id"), r)
+	if !ok {
+		notFound(w, "security group", r.PathValue("id"))
+		return
+	}
+	for _, server := range s.servers {
+		if slices.Contains(server.model.SecurityGroups, rec.model.ID) {
+			writeError(w, http.StatusConflict, fmt.Sprintf("security group %s is in use", rec.model.ID))
+			return
+		}
+	}
+	s.markDeleted(&rec.lifecycle)
+	s.settle()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) createSecurityGroupRule(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.securityGroup, r.PathValue("id"), r)
+	if !ok {
+		notFound(w, "security group", r.PathValue("id"))
+		return
+	}
+	var in stackit.SecurityGroupRuleModel
+	if !decode(w, r, &in) {
+		return
+	}
+	if in.Direction != cloud.DirectionIngress && in.Direction != cloud.DirectionEgress {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid direction %q", in.Direction))
+		return
+	}
+	in.ID = s.newID()
+	rec.model.Rules = append(rec.model.Rules, in)
+	writeJSON(w, http.StatusCreated, in)
+}
+
+func (s *Server) deleteSecurityGroupRule(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.securityGroup, r.PathValue("id"), r)
+	if !ok {
+		notFound(w, "security group", r.PathValue("id"))
+		return
+	}
+	i := slices.IndexFunc(rec.model.Rules, func(rule stackit.SecurityGroupRuleModel) bool {
+		return rule.ID == r.PathValue("rule")
+	})
+	if i < 0 {
+		notFound(w, "security group rule", r.PathValue("rule"))
+		return
+	}
+	rec.model.Rules = slices.Delete(rec.model.Rules, i, i+1)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) createVolume(w http.ResponseWriter, r *http.Request) {
+	var in stackit.VolumeModel
+	if !decode(w, r, &in) {
+		return
+	}
+	if in.Size <= 0 || in.AvailabilityZone == "" {
+		writeError(w, http.StatusBadRequest, "size and availabilityZone are required")
+		return
+	}
+	in.ID = s.newID()
+	in.ServerID = ""
+	in.Encrypted = in.EncryptionParameters != nil
+	in.EncryptionParameters = nil
+	rec := &volumeRecord{lifecycle: s.newLifecycle(r), model: in}
+	s.volumes[in.ID] = rec
+	s.settle()
+	writeJSON(w, http.StatusCreated, rec.model)
+}
+
+func (s *Server) listVolumes(w http.ResponseWriter, r *http.Request) {
+	out := stackit.ListResponse[stackit.VolumeModel]{Items: []stackit.VolumeModel{}}
+	for _, rec := range inScope(s.volumes, r) {
+		if matchLabels(rec.model.Labels, r) {
+			out.Items = append(out.Items, rec.model)
+		}
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (s *Server) getVolume(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.volumes, r.PathValue("id"), r)
+	if !ok {
+		notFound(w, "volume", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, rec.model)
+}
+
+func (s *Server) updateVolume(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.volumes, r.PathValue("id"), r)
+	if !ok {
+		notFound(w, "volume", r.PathValue("id"))
+		return
+	}
+	var in stackit.UpdateVolumeModel
+	if !decode(w, r, &in) {
+		return
+	}
+	if in.Labels != nil {
+		rec.model.Labels = in.Labels
+	}
+	writeJSON(w, http.StatusOK, rec.model)
+}
+
+func (s *Server) deleteVolume(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.volumes, r.PathValue("id"), r)
+	if !ok {
+		notFound(w, "volume", r.PathValue("id"))
+		return
+	}
+	if rec.model.ServerID != "" {
+		writeError(w, http.StatusConflict, fmt.Sprintf("volume %s is attached", rec.model.ID))
+		return
+	}
+	s.markDeleted(&rec.lifecycle)
+	s.settle()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) listImages(w http.ResponseWriter, _ *http.Request) {
+	out := stackit.ListResponse[stackit.ImageModel]{Items: []stackit.ImageModel{}}
+	for _, id := range slices.Sorted(maps.Keys(s.images)) {
+		out.Items = append(out.Items, s.images[id].model)
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (s *Server) getImage(w http.ResponseWriter, r *http.Request) {
+	rec, ok := s.images[r.PathValue("id")]
+	if !ok {
+		notFound(w, "image", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, rec.model)
+}
+
+func (s *Server) createPublicIP(w http.ResponseWriter, r *http.Request) {
+	var in stackit.PublicIPModel
+	if !decode(w, r, &in) {
+		return
+	}
+	in.ID = s.newID()
+	in.IP = fmt.Sprintf("192.0.2.%d", s.nextID%250+1)
+	rec := &publicIPRecord{lifecycle: s.newLifecycle(r), model: in}
+	s.publicIPs[in.ID] = rec
+	writeJSON(w, http.StatusCreated, rec.model)
+}
+
+func (s *Server) listPublicIPs(w http.ResponseWriter, r *http.Request) {
+	out := stackit.ListResponse[stackit.PublicIPModel]{Items: []stackit.PublicIPModel{}}
+	for _, rec := range inScope(s.publicIPs, r) {
+		if matchLabels(rec.model.Labels, r) {
+			out.Items = append(out.Items, rec.model)
+		}
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (s *Server) getPublicIP(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.publicIPs, r.PathValue("id"), r)
+	if !ok {
+		notFound(w, "public IP", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, rec.model)
+}
+
+func (s *Server) deletePublicIP(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.publicIPs, r.PathValue("id"), r)
+	if !ok {
+		notFound(w, "public IP", r.PathValue("id"))
+		return
+	}
+	if rec.model.NetworkInterface != "" {
+		writeError(w, http.StatusConflict, fmt.Sprintf("public IP %s is associated", rec.model.ID))
+		return
+	}
+	s.markDeleted(&rec.lifecycle)
+	s.settle()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) createServerGroup(w http.ResponseWriter, r *http.Request) {
+	var in stackit.ServerGroupModel
+	if !decode(w, r, &in) {
+		return
+	}
+	switch {
+	case in.Name == "":
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	case in.Policy != cloud.ServerGroupPolicyAntiAffinity && in.Policy != cloud.ServerGroupPolicySoftAntiAffinity:
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid policy %q", in.Policy))
+		return
+	}
+	in.ID = s.newID()
+	in.Members = nil
+	rec := &serverGroupRecord{lifecycle: s.newLifecycle(r), model: in}
+	s.serverGroups[in.ID] = rec
+	writeJSON(w, http.StatusCreated, s.renderServerGroup(rec))
+}
+
+func (s *Server) listServerGroups(w http.ResponseWriter, r *http.Request) {
+	out := stackit.ListResponse[stackit.ServerGroupModel]{Items: []stackit.ServerGroupModel{}}
+	for _, rec := range inScope(s.serverGroups, r) {
+		out.Items = append(out.Items, s.renderServerGroup(rec))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (s *Server) getServerGroup(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.serverGroups, r.PathValue("id"), r)
+	if !ok {
+		notFound(w, "server group", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, s.renderServerGroup(rec))
+}
+
+// deleteServerGroup removes a server group immediately. Its members keep
+// running.
+func (s *Server) deleteServerGroup(w http.ResponseWriter, r *http.Request) {
+	if _, ok := find(s.serverGroups, r.PathValue("id"), r); !ok {
+		notFound(w, "server group", r.PathValue("id"))
+		return
+	}
+	delete(s.serverGroups, r.PathValue("id"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// renderServerGroup returns the API representation of a server group with
+// the servers that are not deleted yet as members.
+func (s *Server) renderServerGroup(r *serverGroupRecord) stackit.ServerGroupModel {
+	m := r.model
+	for _, id := range slices.Sorted(maps.Keys(s.servers)) {
+		if s.servers[id].model.AffinityGroup == m.ID {
+			m.Members = append(m.Members, id)
+		}
+	}
+	return m
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.projects[r.PathValue("project")]
+	if !ok {
+		notFound(w, "project", r.PathValue("project"))
+		return
+	}
+	writeJSON(w, http.StatusOK, project)
+}
+
+func (s *Server) listAvailabilityZones(w http.ResponseWriter, r *http.Request) {
+	zones := s.opts.AvailabilityZones
+	if zones == nil {
+		zones = []string{"{region}-1", "{region}-2", "{region}-3"}
+	}
+	out := stackit.ListResponse[string]{Items: []string{}}
+	for _, zone := range zones {
+		out.Items = append(out.Items, strings.ReplaceAll(zone, "{region}", r.PathValue("region")))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
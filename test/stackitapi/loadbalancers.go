@@ -0,0 +1,149 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stackitapi
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud/stackit"
+)
+
+func loadBalancerKey(r *http.Request, name string) string {
+	return scopeOf(r) + "/" + name
+}
+
+// assignLoadBalancerAddresses sets the addresses of a ready load balancer.
+func (s *Server) assignLoadBalancerAddresses(r *loadBalancerRecord) {
+	if r.model.PrivateAddress == "" {
+		for _, n := range r.model.Networks {
+			if network, ok := s.networks[n.NetworkID]; ok {
+				r.model.PrivateAddress = s.allocateIP(network)
+			}
+		}
+	}
+	privateOnly := r.model.Options != nil && r.model.Options.PrivateNetworkOnly
+	if r.model.ExternalAddress == "" && !privateOnly {
+		s.nextID++
+		r.model.ExternalAddress = fmt.Sprintf("198.51.100.%d", s.nextID%250+1)
+	}
+}
+
+func (s *Server) validateLoadBalancer(w http.ResponseWriter, r *http.Request, lb *stackit.LoadBalancerModel) bool {
+	pools := map[string]bool{}
+	for _, pool := range lb.TargetPools {
+		pools[pool.Name] = true
+	}
+	for _, listener := range lb.Listeners {
+		if !pools[listener.TargetPool] {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("listener references unknown target pool %q",
+				listener.TargetPool))
+			return false
+		}
+	}
+	for _, n := range lb.Networks {
+		if _, ok := find(s.networks, n.NetworkID, r); !ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("network %s not found", n.NetworkID))
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) createLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	var in stackit.LoadBalancerModel
+	if !decode(w, r, &in) {
+		return
+	}
+	if in.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+	if _, ok := s.loadBalancers[loadBalancerKey(r, in.Name)]; ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf("load balancer %s already exists", in.Name))
+		return
+	}
+	if !s.validateLoadBalancer(w, r, &in) {
+		return
+	}
+	in.ExternalAddress = ""
+	in.PrivateAddress = ""
+	in.Errors = nil
+	rec := &loadBalancerRecord{lifecycle: s.newLifecycle(r), model: in, version: 1}
+	rec.model.Version = strconv.Itoa(rec.version)
+	s.loadBalancers[loadBalancerKey(r, in.Name)] = rec
+	s.settle()
+	writeJSON(w, http.StatusOK, rec.model)
+}
+
+func (s *Server) listLoadBalancers(w http.ResponseWriter, r *http.Request) {
+	out := stackit.ListResponse[stackit.LoadBalancerModel]{Items: []stackit.LoadBalancerModel{}}
+	for _, rec := range inScope(s.loadBalancers, r) {
+		out.Items = append(out.Items, rec.model)
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (s *Server) getLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.loadBalancers, loadBalancerKey(r, r.PathValue("name")), r)
+	if !ok {
+		notFound(w, "load balancer", r.PathValue("name"))
+		return
+	}
+	writeJSON(w, http.StatusOK, rec.model)
+}
+
+func (s *Server) updateLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.loadBalancers, loadBalancerKey(r, r.PathValue("name")), r)
+	if !ok {
+		notFound(w, "load balancer", r.PathValue("name"))
+		return
+	}
+	var in stackit.LoadBalancerModel
+	if !decode(w, r, &in) {
+		return
+	}
+	if in.Version != rec.model.Version {
+		writeError(w, http.StatusConflict, fmt.Sprintf("version %q does not match current version %q",
+			in.Version, rec.model.Version))
+		return
+	}
+	if !s.validateLoadBalancer(w, r, &in) {
+		return
+	}
+	rec.version++
+	in.Name = rec.model.Name
+	in.Version = strconv.Itoa(rec.version)
+	in.ExternalAddress = rec.model.ExternalAddress
+	in.PrivateAddress = rec.model.PrivateAddress
+	in.Status = rec.model.Status
+	in.Errors = rec.model.Errors
+	rec.model = in
+	writeJSON(w, http.StatusOK, rec.model)
+}
+
+func (s *Server) deleteLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.loadBalancers, loadBalancerKey(r, r.PathValue("name")), r)
+	if !ok {
+		notFound(w, "load balancer", r.PathValue("name"))
+		return
+	}
+	s.markDeleted(&rec.lifecycle)
+	s.settle()
+	w.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,534 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package stackitapi provides a local stand-in for the STACKIT IaaS, Load
+// Balancer, Kubernetes Engine and Resource Manager APIs. It keeps all state in memory and
+// supports configurable latency, asynchronous provisioning and injected
+// failures, so that the controllers can be exercised without STACKIT
+// credentials.
+//
+// All APIs are served from the same base URL, which can therefore be used
+// as the IaaS, Load Balancer and Kubernetes Engine endpoint of the STACKIT
+// client.
+package stackitapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud/stackit"
+)
+
+const scoped = "/v2/projects/{project}/regions/{region}"
+
+// life gives generic helpers access to the lifecycle of a record.
+func (l *lifecycle) life() *lifecycle { return l }
+
+type record interface{ life() *lifecycle }
+
+// find returns the record with the given key if it belongs to the scope of r.
+func find[R record](m map[string]R, key string, r *http.Request) (R, bool) {
+	rec, ok := m[key]
+	if !ok || rec.life().scope != scopeOf(r) {
+		var zero R
+		return zero, false
+	}
+	return rec, true
+}
+
+// inScope returns the records of m in the scope of r, sorted by key.
+func inScope[R record](m map[string]R, r *http.Request) []R {
+	var out []R
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		if rec := m[key]; rec.life().scope == scopeOf(r) {
+			out = append(out, rec)
+		}
+	}
+	return out
+}
+
+func (s *Server) routes() {
+	s.handle("POST "+scoped+"/servers", s.createServer)
+	s.handle("GET "+scoped+"/servers", s.listServers)
+	s.handle("GET "+scoped+"/servers/{id}", s.getServer)
+	s.handle("DELETE "+scoped+"/servers/{id}", s.deleteServer)
+	s.handle("GET "+scoped+"/servers/{id}/nics", s.listServerNICs)
+	s.handle("PUT "+scoped+"/servers/{id}/volume-attachments/{volume}", s.attachVolume)
+	s.handle("DELETE "+scoped+"/servers/{id}/volume-attachments/{volume}", s.detachVolume)
+	s.handle("PUT "+scoped+"/servers/{id}/public-ips/{ip}", s.associatePublicIP)
+	s.handle("DELETE "+scoped+"/servers/{id}/public-ips/{ip}", s.disassociatePublicIP)
+
+	s.handle("POST "+scoped+"/networks", s.createNetwork)
+	s.handle("GET "+scoped+"/networks", s.listNetworks)
+	s.handle("GET "+scoped+"/networks/{id}", s.getNetwork)
+	s.handle("DELETE "+scoped+"/networks/{id}", s.deleteNetwork)
+
+	s.handle("POST "+scoped+"/security-groups", s.createSecurityGroup)
+	s.handle("GET "+scoped+"/security-groups", s.listSecurityGroups)
+	s.handle("GET "+scoped+"/security-groups/{id}", s.getSecurityGroup)
+	s.handle("DELETE "+scoped+"/security-groups/{id}", s.deleteSecurityGroup)
+	s.handle("POST "+scoped+"/security-groups/{id}/rules", s.createSecurityGroupRule)
+	s.handle("DELETE "+scoped+"/security-groups/{id}/rules/{rule}", s.deleteSecurityGroupRule)
+
+	s.handle("POST "+scoped+"/volumes", s.createVolume)
+	s.handle("GET "+scoped+"/volumes", s.listVolumes)
+	s.handle("GET "+scoped+"/volumes/{id}", s.getVolume)
+	s.handle("PATCH "+scoped+"/volumes/{id}", s.updateVolume)
+	s.handle("DELETE "+scoped+"/volumes/{id}", s.deleteVolume)
+
+	s.handle("GET "+scoped+"/images", s.listImages)
+	s.handle("GET "+scoped+"/images/{id}", s.getImage)
+
+	s.handle("POST "+scoped+"/public-ips", s.createPublicIP)
+	s.handle("GET "+scoped+"/public-ips", s.listPublicIPs)
+	s.handle("GET "+scoped+"/public-ips/{id}", s.getPublicIP)
+	s.handle("DELETE "+scoped+"/public-ips/{id}", s.deletePublicIP)
+
+	s.handle("POST "+scoped+"/server-groups", s.createServerGroup)
+	s.handle("GET "+scoped+"/server-groups", s.listServerGroups)
+	s.handle("GET "+scoped+"/server-groups/{id}", s.getServerGroup)
+	s.handle("DELETE "+scoped+"/server-groups/{id}", s.deleteServerGroup)
+
+	s.handle("POST "+scoped+"/load-balancers", s.createLoadBalancer)
+	s.handle("GET "+scoped+"/load-balancers", s.listLoadBalancers)
+	s.handle("GET "+scoped+"/load-balancers/{name}", s.getLoadBalancer)
+	s.handle("PUT "+scoped+"/load-balancers/{name}", s.updateLoadBalancer)
+	s.handle("DELETE "+scoped+"/load-balancers/{name}", s.deleteLoadBalancer)
+
+	s.handle("PUT "+scoped+"/clusters/{name}", s.createOrUpdateSKECluster)
+	s.handle("GET "+scoped+"/clusters/{name}", s.getSKECluster)
+	s.handle("DELETE "+scoped+"/clusters/{name}", s.deleteSKECluster)
+	s.handle("POST "+scoped+"/clusters/{name}/kubeconfig", s.createSKEKubeconfig)
+
+	s.handle("GET /v2/regions/{region}/availability-zones", s.listAvailabilityZones)
+
+	s.handle("GET /v2/projects/{project}", s.getProject)
+}
+
+// Options configures the behavior of a Server.
+type Options struct {
+	// Latency is added to every response.
+	Latency time.Duration
+
+	// ProvisioningDelay is the time resources spend in a transitional
+	// state, for example CREATING or DELETING, before reaching their final
+	// state. Resources settle immediately if zero.
+	ProvisioningDelay time.Duration
+
+	// AvailabilityZones are the availability zones of every region, with
+	// the region name as placeholder, for example "{region}-1". Defaults to
+	// three zones per region.
+	AvailabilityZones []string
+}
+
+// Failure describes requests that fail with the given status code.
+type Failure struct {
+	// Method matches the request method. Empty matches all methods.
+	Method string
+
+	// Path matches requests whose path contains it. Empty matches all paths.
+	Path string
+
+	// StatusCode is the status code of the failed response.
+	StatusCode int
+
+	// Message is the error message of the failed response.
+	Message string
+
+	// Times is the number of requests that fail. Zero fails all matching
+	// requests until ClearFailures is called.
+	Times int
+}
+
+func (f *Failure) matches(r *http.Request) bool {
+	return (f.Method == "" || f.Method == r.Method) && strings.Contains(r.URL.Path, f.Path)
+}
+
+// Server is a fake STACKIT API server.
+type Server struct {
+	opts Options
+	mux  *http.ServeMux
+	http *httptest.Server
+
+	mu       sync.Mutex
+	now      func() time.Time
+	nextID   int
+	failures []*Failure
+	requests []string
+
+	projects      map[string]*stackit.ProjectModel
+	servers       map[string]*serverRecord
+	networks      map[string]*networkRecord
+	securityGroup map[string]*securityGroupRecord
+	volumes       map[string]*volumeRecord
+	images        map[string]*imageRecord
+	publicIPs     map[string]*publicIPRecord
+	serverGroups  map[string]*serverGroupRecord
+	loadBalancers map[string]*loadBalancerRecord
+	skeClusters   map[string]*skeClusterRecord
+}
+
+// lifecycle tracks when a resource leaves its transitional state.
+type lifecycle struct {
+	scope    string
+	readyAt  time.Time
+	deleteAt time.Time
+}
+
+func (l *lifecycle) ready(now time.Time) bool {
+	return !now.Before(l.readyAt)
+}
+
+func (l *lifecycle) deleting() bool {
+	return !l.deleteAt.IsZero()
+}
+
+func (l *lifecycle) deleted(now time.Time) bool {
+	return l.deleting() && !now.Before(l.deleteAt)
+}
+
+type serverRecord struct {
+	lifecycle
+	model        stackit.ServerModel
+	userData     []byte
+	bootVolumeID string
+	failed       bool
+}
+
+type networkRecord struct {
+	lifecycle
+	model  stackit.NetworkModel
+	nextIP int
+}
+
+type securityGroupRecord struct {
+	lifecycle
+	model stackit.SecurityGroupModel
+}
+
+type volumeRecord struct {
+	lifecycle
+	model stackit.VolumeModel
+}
+
+type imageRecord struct {
+	lifecycle
+	model stackit.ImageModel
+}
+
+type publicIPRecord struct {
+	lifecycle
+	model stackit.PublicIPModel
+}
+
+type serverGroupRecord struct {
+	lifecycle
+	model stackit.ServerGroupModel
+}
+
+type loadBalancerRecord struct {
+	lifecycle
+	model   stackit.LoadBalancerModel
+	version int
+	failed  bool
+}
+
+type skeClusterRecord struct {
+	lifecycle
+	model stackit.SKEClusterModel
+	// updated is set once the cluster has been updated, so that it is
+	// reconciling rather than creating until it is ready again.
+	updated bool
+}
+
+// NewServer starts a Server. It must be closed with Close.
+func NewServer(opts Options) *Server {
+	s := NewUnstartedServer(opts)
+	s.http = httptest.NewServer(s)
+	return s
+}
+
+// NewUnstartedServer returns a Server that is not listening. It can be served
+// with any http.Server as it implements http.Handler.
+func NewUnstartedServer(opts Options) *Server {
+	s := &Server{
+		opts:          opts,
+		mux:           http.NewServeMux(),
+		now:           time.Now,
+		projects:      map[string]*stackit.ProjectModel{},
+		servers:       map[string]*serverRecord{},
+		networks:      map[string]*networkRecord{},
+		securityGroup: map[string]*securityGroupRecord{},
+		volumes:       map[string]*volumeRecord{},
+		images:        map[string]*imageRecord{},
+		publicIPs:     map[string]*publicIPRecord{},
+		serverGroups:  map[string]*serverGroupRecord{},
+		loadBalancers: map[string]*loadBalancerRecord{},
+		skeClusters:   map[string]*skeClusterRecord{},
+	}
+	s.routes()
+	return s
+}
+
+// URL returns the base URL of a started Server.
+func (s *Server) URL() string {
+	return s.http.URL
+}
+
+// Close shuts down a started Server.
+func (s *Server) Close() {
+	s.http.Close()
+}
+
+// InjectFailure makes matching requests fail until the failure is used up
+// or ClearFailures is called.
+func (s *Server) InjectFailure(f Failure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, &f)
+}
+
+// ClearFailures removes all injected failures.
+func (s *Server) ClearFailures() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = nil
+}
+
+// Requests returns the "METHOD path" of all requests served so far.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// AddProject registers a project with the Resource Manager API.
+func (s *Server) AddProject(id, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.projects[id] = &stackit.ProjectModel{ProjectID: id, Name: name, LifecycleState: "ACTIVE"}
+}
+
+// AddImage makes an image available to all projects and returns its ID.
+func (s *Server) AddImage(image stackit.ImageModel) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if image.ID == "" {
+		image.ID = s.newID()
+	}
+	if image.Status == "" {
+		image.Status = cloud.ImageStatusAvailable
+	}
+	if image.CreatedAt == nil {
+		now := s.now()
+		image.CreatedAt = &now
+	}
+	s.images[image.ID] = &imageRecord{model: image}
+	return image.ID
+}
+
+// FailServer moves a server into the ERROR state.
+func (s *Server) FailServer(id, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r, ok := s.servers[id]; ok {
+		r.failed = true
+		r.model.ErrorMessage = message
+	}
+}
+
+// FailLoadBalancer moves a load balancer into the STATUS_ERROR state.
+func (s *Server) FailLoadBalancer(name, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, r := range s.loadBalancers {
+		if r.model.Name == name {
+			r.failed = true
+			r.model.Errors = []stackit.LoadBalancerErrorModel{{Type: "TYPE_UNSPECIFIED", Description: message}}
+		}
+	}
+}
+
+// Servers returns all servers that are not deleted yet.
+func (s *Server) Servers() []stackit.ServerModel {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settle()
+	out := make([]stackit.ServerModel, 0, len(s.servers))
+	for _, r := range s.servers {
+		out = append(out, r.model)
+	}
+	return out
+}
+
+// UserData returns the decoded user data the server with the given ID was
+// created with.
+func (s *Server) UserData(id string) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r, ok := s.servers[id]; ok {
+		return r.userData
+	}
+	return nil
+}
+
+// ServerGroups returns all server groups with their members.
+func (s *Server) ServerGroups() []stackit.ServerGroupModel {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settle()
+	out := make([]stackit.ServerGroupModel, 0, len(s.serverGroups))
+	for _, r := range s.serverGroups {
+		out = append(out, s.renderServerGroup(r))
+	}
+	return out
+}
+
+// LoadBalancers returns all load balancers that are not deleted yet.
+func (s *Server) LoadBalancers() []stackit.LoadBalancerModel {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settle()
+	out := make([]stackit.LoadBalancerModel, 0, len(s.loadBalancers))
+	for _, r := range s.loadBalancers {
+		out = append(out, r.model)
+	}
+	return out
+}
+
+// SKEClusters returns all Kubernetes Engine clusters that are not deleted
+// yet.
+func (s *Server) SKEClusters() []stackit.SKEClusterModel {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settle()
+	out := make([]stackit.SKEClusterModel, 0, len(s.skeClusters))
+	for _, r := range s.skeClusters {
+		out = append(out, s.renderSKECluster(r))
+	}
+	return out
+}
+
+// ResourceCount returns the number of STACKIT resources that are not deleted
+// yet, excluding images.
+func (s *Server) ResourceCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settle()
+	return len(s.servers) + len(s.networks) + len(s.securityGroup) + len(s.volumes) +
+		len(s.publicIPs) + len(s.serverGroups) + len(s.loadBalancers) + len(s.skeClusters)
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.opts.Latency > 0 {
+		time.Sleep(s.opts.Latency)
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	for i, f := range s.failures {
+		if !f.matches(r) {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.failures = append(s.failures[:i], s.failures[i+1:]...)
+			}
+		}
+		s.mu.Unlock()
+		writeError(w, f.StatusCode, f.Message)
+		return
+	}
+	s.mu.Unlock()
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// handle registers a handler that runs with s.mu held and all resources
+// settled.
+func (s *Server) handle(pattern string, h func(w http.ResponseWriter, r *http.Request)) {
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.settle()
+		h(w, r)
+	})
+}
+
+func (s *Server) newID() string {
+	s.nextID++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.nextID)
+}
+
+func (s *Server) newLifecycle(r *http.Request) lifecycle {
+	return lifecycle{scope: scopeOf(r), readyAt: s.now().Add(s.opts.ProvisioningDelay)}
+}
+
+func (s *Server) markDeleted(l *lifecycle) {
+	if !l.deleting() {
+		l.deleteAt = s.now().Add(s.opts.ProvisioningDelay)
+	}
+}
+
+func scopeOf(r *http.Request) string {
+	return r.PathValue("project") + "/" + r.PathValue("region")
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, stackit.ErrorResponse{Code: status, Message: message})
+}
+
+func notFound(w http.ResponseWriter, kind, id string) {
+	writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", kind, id))
+}
+
+func matchLabels(have map[string]string, r *http.Request) bool {
+	selector := r.URL.Query().Get("label_selector")
+	if selector == "" {
+		return true
+	}
+	for _, term := range strings.Split(selector, ",") {
+		k, v, _ := strings.Cut(term, "=")
+		if have[k] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,436 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stackitapi
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud/stackit"
+)
+
+// fakeClock is a manually advanced clock.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Step(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestServer(t *testing.T, opts Options) (*Server, *fakeClock, cloud.Interface) {
+	t.Helper()
+	clock := &fakeClock{now: time.Now()}
+	s := NewServer(opts)
+	s.now = clock.Now
+	t.Cleanup(s.Close)
+
+	c, err := stackit.New(cloud.Scope{ProjectID: "project", Region: "eu01"}, stackit.Options{
+		IaaSEndpoint:         s.URL(),
+		LoadBalancerEndpoint: s.URL(),
+		SKEEndpoint:          s.URL(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, clock, c
+}
+
+func TestServerLifecycle(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	s, clock, c := newTestServer(t, Options{ProvisioningDelay: time.Minute})
+	imageID := s.AddImage(stackit.ImageModel{Name: "ubuntu"})
+
+	network, err := c.Networks().Create(ctx, cloud.CreateNetworkRequest{Name: "net", IPv4Prefix: "10.1.0.0/24"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(network.Status).To(Equal(cloud.NetworkStatusCreating))
+
+	server, err := c.Servers().Create(ctx, cloud.CreateServerRequest{
+		Name:        "machine",
+		MachineType: "c1.2",
+		ImageID:     imageID,
+		NetworkID:   network.ID,
+		BootVolume:  &cloud.BootVolume{Size: 20, DeleteOnTermination: true},
+		UserData:    []byte("#cloud-config\n"),
+		Labels:      map[string]string{cloud.MachineUIDLabel: "uid"},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(server.Status).To(Equal(cloud.ServerStatusCreating))
+	g.Expect(s.UserData(server.ID)).To(Equal([]byte("#cloud-config\n")))
+	g.Expect(server.NICs).To(HaveLen(1))
+	g.Expect(server.NICs[0].IPv4).To(Equal("10.1.0.10"))
+	g.Expect(server.VolumeIDs).To(HaveLen(1))
+
+	clock.Step(time.Minute)
+	server, err = c.Servers().Get(ctx, server.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(server.Status).To(Equal(cloud.ServerStatusActive))
+
+	servers, err := c.Servers().List(ctx, map[string]string{cloud.MachineUIDLabel: "uid"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(servers).To(HaveLen(1))
+	servers, err = c.Servers().List(ctx, map[string]string{cloud.MachineUIDLabel: "other"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(servers).To(BeEmpty())
+
+	_, err = c.Servers().Create(ctx, cloud.CreateServerRequest{
+		Name:        "too-large",
+		MachineType: "c1.2",
+		ImageID:     imageID,
+		UserData:    make([]byte, cloud.MaxUserDataSize),
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("userData exceeds")))
+
+	err = c.Networks().Delete(ctx, network.ID)
+	g.Expect(err).To(MatchError(ContainSubstring("attached servers")))
+
+	g.Expect(c.Servers().Delete(ctx, server.ID)).To(Succeed())
+	server, err = c.Servers().Get(ctx, server.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(server.Status).To(Equal(cloud.ServerStatusDeleting))
+
+	clock.Step(time.Minute)
+	_, err = c.Servers().Get(ctx, server.ID)
+	g.Expect(cloud.IsNotFound(err)).To(BeTrue())
+	_, err = c.Volumes().Get(ctx, server.VolumeIDs[0])
+	g.Expect(cloud.IsNotFound(err)).To(BeTrue())
+
+	g.Expect(c.Networks().Delete(ctx, network.ID)).To(Succeed())
+	clock.Step(time.Minute)
+	g.Expect(s.ResourceCount()).To(BeZero())
+}
+
+func TestVolumeLifecycle(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	s, clock, c := newTestServer(t, Options{ProvisioningDelay: time.Minute})
+	imageID := s.AddImage(stackit.ImageModel{Name: "ubuntu"})
+
+	volume, err := c.Volumes().Create(ctx, cloud.CreateVolumeRequest{
+		Name:             "machine-etcd",
+		Size:             10,
+		AvailabilityZone: "eu01-1",
+		Encryption:       &cloud.VolumeEncryption{KeyRingID: "ring", KeyID: "key", KeyVersion: 1, ServiceAccount: "sa"},
+		Labels:           map[string]string{"a": "1", "b": "2"},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(volume.Status).To(Equal(cloud.VolumeStatusCreating))
+	g.Expect(volume.Encrypted).To(BeTrue())
+	volume, err = c.Volumes().UpdateLabels(ctx, volume.ID, map[string]string{"a": "1"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(volume.Labels).To(Equal(map[string]string{"a": "1"}))
+	volume, err = c.Volumes().UpdateLabels(ctx, volume.ID, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(volume.Labels).To(BeEmpty())
+
+	req := cloud.CreateServerRequest{
+		Name:             "machine",
+		MachineType:      "c1.2",
+		ImageID:          imageID,
+		AvailabilityZone: "eu01-1",
+		VolumeIDs:        []string{volume.ID},
+	}
+	_, err = c.Servers().Create(ctx, req)
+	g.Expect(err).To(MatchError(ContainSubstring("is not available")))
+
+	clock.Step(time.Minute)
+	_, err = c.Servers().Create(ctx, cloud.CreateServerRequest{
+		Name:             "other-zone",
+		MachineType:      "c1.2",
+		ImageID:          imageID,
+		AvailabilityZone: "eu01-2",
+		VolumeIDs:        []string{volume.ID},
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("availability zone eu01-1")))
+	server, err := c.Servers().Create(ctx, req)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(server.VolumeIDs).To(ConsistOf(volume.ID))
+	volume, err = c.Volumes().Get(ctx, volume.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(volume.Status).To(Equal(cloud.VolumeStatusAttached))
+	g.Expect(cloud.IsConflict(c.Volumes().Delete(ctx, volume.ID))).To(BeTrue())
+
+	g.Expect(c.Servers().Delete(ctx, server.ID)).To(Succeed())
+	clock.Step(time.Minute)
+	volume, err = c.Volumes().Get(ctx, volume.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(volume.Status).To(Equal(cloud.VolumeStatusAvailable))
+	g.Expect(c.Volumes().Delete(ctx, volume.ID)).To(Succeed())
+	clock.Step(time.Minute)
+	g.Expect(s.ResourceCount()).To(BeZero())
+}
+
+func TestPublicIPLifecycle(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	s, _, c := newTestServer(t, Options{})
+	imageID := s.AddImage(stackit.ImageModel{Name: "ubuntu"})
+
+	network, err := c.Networks().Create(ctx, cloud.CreateNetworkRequest{Name: "net", IPv4Prefix: "10.1.0.0/24"})
+	g.Expect(err).NotTo(HaveOccurred())
+	server, err := c.Servers().Create(ctx, cloud.CreateServerRequest{
+		Name:        "machine",
+		MachineType: "c1.2",
+		ImageID:     imageID,
+		NetworkID:   network.ID,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ip, err := c.PublicIPs().Create(ctx, cloud.CreatePublicIPRequest{Labels: map[string]string{cloud.MachineUIDLabel: "uid"}})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ip.IP).NotTo(BeEmpty())
+	ips, err := c.PublicIPs().List(ctx, map[string]string{cloud.MachineUIDLabel: "uid"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ips).To(ConsistOf(HaveField("ID", ip.ID)))
+
+	g.Expect(c.PublicIPs().Associate(ctx, server.ID, ip.ID)).To(Succeed())
+	server, err = c.Servers().Get(ctx, server.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(server.NICs[0].PublicIP).To(Equal(ip.IP))
+	err = c.PublicIPs().Delete(ctx, ip.ID)
+	g.Expect(cloud.IsConflict(err)).To(BeTrue())
+
+	g.Expect(c.PublicIPs().Disassociate(ctx, server.ID, ip.ID)).To(Succeed())
+	ip, err = c.PublicIPs().Get(ctx, ip.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ip.NetworkInterface).To(BeEmpty())
+	g.Expect(c.PublicIPs().Delete(ctx, ip.ID)).To(Succeed())
+	_, err = c.PublicIPs().Get(ctx, ip.ID)
+	g.Expect(cloud.IsNotFound(err)).To(BeTrue())
+}
+
+func TestServerGroupLifecycle(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	s, _, c := newTestServer(t, Options{})
+	imageID := s.AddImage(stackit.ImageModel{Name: "ubuntu"})
+
+	_, err := c.ServerGroups().Create(ctx, cloud.CreateServerGroupRequest{Name: "group", Policy: "affinity"})
+	g.Expect(err).To(MatchError(ContainSubstring("invalid policy")))
+	group, err := c.ServerGroups().Create(ctx, cloud.CreateServerGroupRequest{
+		Name:   "group",
+		Policy: cloud.ServerGroupPolicyAntiAffinity,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(group.MemberIDs).To(BeEmpty())
+
+	_, err = c.Servers().Create(ctx, cloud.CreateServerRequest{
+		Name:          "machine",
+		MachineType:   "c1.2",
+		ImageID:       imageID,
+		ServerGroupID: "missing",
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("server group missing not found")))
+	server, err := c.Servers().Create(ctx, cloud.CreateServerRequest{
+		Name:          "machine",
+		MachineType:   "c1.2",
+		ImageID:       imageID,
+		ServerGroupID: group.ID,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(server.ServerGroupID).To(Equal(group.ID))
+
+	groups, err := c.ServerGroups().List(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(groups).To(ConsistOf(And(HaveField("ID", group.ID), HaveField("MemberIDs", []string{server.ID}))))
+
+	g.Expect(c.Servers().Delete(ctx, server.ID)).To(Succeed())
+	group, err = c.ServerGroups().Get(ctx, group.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(group.MemberIDs).To(BeEmpty())
+	g.Expect(c.ServerGroups().Delete(ctx, group.ID)).To(Succeed())
+	_, err = c.ServerGroups().Get(ctx, group.ID)
+	g.Expect(cloud.IsNotFound(err)).To(BeTrue())
+	g.Expect(s.ResourceCount()).To(BeZero())
+}
+
+func TestLoadBalancerLifecycle(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	s, clock, c := newTestServer(t, Options{ProvisioningDelay: time.Minute})
+
+	network, err := c.Networks().Create(ctx, cloud.CreateNetworkRequest{Name: "net", IPv4Prefix: "10.1.0.0/24"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	lb, err := c.LoadBalancers().Create(ctx, cloud.LoadBalancer{
+		Name:        "api",
+		NetworkID:   network.ID,
+		Listeners:   []cloud.Listener{{Port: 6443, TargetPool: "control-plane"}},
+		TargetPools: []cloud.TargetPool{{Name: "control-plane", TargetPort: 6443}},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(lb.Status).To(Equal(cloud.LoadBalancerStatusPending))
+	g.Expect(lb.ExternalAddress).To(BeEmpty())
+
+	clock.Step(time.Minute)
+	lb, err = c.LoadBalancers().Get(ctx, "api")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(lb.Status).To(Equal(cloud.LoadBalancerStatusReady))
+	g.Expect(lb.ExternalAddress).NotTo(BeEmpty())
+
+	lb.TargetPools[0].Targets = []cloud.Target{{DisplayName: "machine", IP: "10.1.0.10"}}
+	lb, err = c.LoadBalancers().Update(ctx, *lb)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(lb.TargetPools[0].Targets).To(HaveLen(1))
+
+	s.FailLoadBalancer("api", "quota exceeded")
+	lb, err = c.LoadBalancers().Get(ctx, "api")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(lb.Status).To(Equal(cloud.LoadBalancerStatusError))
+	g.Expect(lb.Errors).To(ConsistOf(ContainSubstring("quota exceeded")))
+
+	g.Expect(c.LoadBalancers().Delete(ctx, "api")).To(Succeed())
+	clock.Step(time.Minute)
+	_, err = c.LoadBalancers().Get(ctx, "api")
+	g.Expect(cloud.IsNotFound(err)).To(BeTrue())
+}
+
+func TestManagedClusterLifecycle(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	s, clock, c := newTestServer(t, Options{ProvisioningDelay: time.Minute})
+
+	pool := cloud.NodePool{
+		Name:              "pool",
+		MachineType:       "c1.2",
+		ImageName:         "flatcar",
+		ImageVersion:      "3975.2.0",
+		Minimum:           1,
+		Maximum:           3,
+		VolumeSize:        20,
+		AvailabilityZones: []string{"eu01-1"},
+		Taints:            []cloud.Taint{{Key: "dedicated", Value: "ingress", Effect: "NoSchedule"}},
+	}
+	_, err := c.ManagedClusters().CreateOrUpdate(ctx, cloud.ManagedCluster{
+		Name:              "too-long-name",
+		KubernetesVersion: "1.31.4",
+		NodePools:         []cloud.NodePool{pool},
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("cluster name")))
+	_, err = c.ManagedClusters().CreateOrUpdate(ctx, cloud.ManagedCluster{Name: "ske", KubernetesVersion: "1.31.4"})
+	g.Expect(err).To(MatchError(ContainSubstring("node pool is required")))
+
+	cluster, err := c.ManagedClusters().CreateOrUpdate(ctx, cloud.ManagedCluster{
+		Name:              "ske",
+		KubernetesVersion: "1.31.4",
+		NodePools:         []cloud.NodePool{pool},
+		Maintenance:       &cloud.Maintenance{AutoUpdateKubernetesVersion: true, Start: "03:00:00Z", End: "04:00:00Z"},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cluster.State).To(Equal(cloud.ManagedClusterStateCreating))
+	g.Expect(cluster.NodePools).To(Equal([]cloud.NodePool{pool}))
+	g.Expect(cluster.Maintenance.Start).To(Equal("03:00:00Z"))
+	g.Expect(s.SKEClusters()[0].Maintenance.TimeWindow.Start).To(Equal("0000-01-01T03:00:00Z"))
+	_, err = c.ManagedClusters().CreateKubeconfig(ctx, "ske", time.Hour)
+	g.Expect(err).To(MatchError(ContainSubstring("not ready")))
+
+	clock.Step(time.Minute)
+	cluster, err = c.ManagedClusters().Get(ctx, "ske")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cluster.State).To(Equal(cloud.ManagedClusterStateHealthy))
+
+	kubeconfig, err := c.ManagedClusters().CreateKubeconfig(ctx, "ske", time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(kubeconfig.Kubeconfig)).To(ContainSubstring("server: https://api.ske.project."))
+	g.Expect(kubeconfig.ExpiresAt).To(BeTemporally("~", clock.Now().Add(time.Hour), time.Second))
+
+	pool.Maximum = 5
+	cluster, err = c.ManagedClusters().CreateOrUpdate(ctx, cloud.ManagedCluster{
+		Name:              "ske",
+		KubernetesVersion: "1.31.4",
+		NodePools:         []cloud.NodePool{pool},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cluster.State).To(Equal(cloud.ManagedClusterStateReconciling))
+	g.Expect(cluster.NodePools[0].Maximum).To(Equal(int32(5)))
+
+	g.Expect(c.ManagedClusters().Delete(ctx, "ske")).To(Succeed())
+	cluster, err = c.ManagedClusters().Get(ctx, "ske")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cluster.State).To(Equal(cloud.ManagedClusterStateDeleting))
+
+	clock.Step(time.Minute)
+	_, err = c.ManagedClusters().Get(ctx, "ske")
+	g.Expect(cloud.IsNotFound(err)).To(BeTrue())
+	g.Expect(s.ResourceCount()).To(BeZero())
+}
+
+func TestInjectedFailures(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	s, _, c := newTestServer(t, Options{})
+
+	s.InjectFailure(Failure{
+		Method:     http.MethodPost,
+		Path:       "/networks",
+		StatusCode: http.StatusServiceUnavailable,
+		Message:    "maintenance",
+		Times:      1,
+	})
+	_, err := c.Networks().Create(ctx, cloud.CreateNetworkRequest{Name: "net"})
+	g.Expect(err).To(MatchError(ContainSubstring("maintenance")))
+
+	network, err := c.Networks().Create(ctx, cloud.CreateNetworkRequest{Name: "net"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(network.Status).To(Equal(cloud.NetworkStatusCreated))
+
+	s.InjectFailure(Failure{StatusCode: http.StatusInternalServerError, Message: "boom"})
+	_, err = c.Networks().Get(ctx, network.ID)
+	g.Expect(err).To(HaveOccurred())
+	_, err = c.Servers().List(ctx, nil)
+	g.Expect(err).To(HaveOccurred())
+
+	s.ClearFailures()
+	_, err = c.Networks().Get(ctx, network.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestScopesAreIsolated(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	s, _, c := newTestServer(t, Options{})
+
+	other, err := stackit.New(cloud.Scope{ProjectID: "other", Region: "eu01"}, stackit.Options{
+		IaaSEndpoint: s.URL(),
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	network, err := c.Networks().Create(ctx, cloud.CreateNetworkRequest{Name: "net"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = other.Networks().Get(ctx, network.ID)
+	g.Expect(cloud.IsNotFound(err)).To(BeTrue())
+	networks, err := other.Networks().List(ctx, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(networks).To(BeEmpty())
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stackitapi
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud"
+	"github.com/aniruddha2000/cluster-api-provider-stackit/internal/cloud/stackit"
+)
+
+// Limits of the Kubernetes Engine API.
+const (
+	maxSKEClusterNameLength  = 11
+	maxSKENodepoolNameLength = 15
+)
+
+func skeClusterKey(r *http.Request, name string) string {
+	return scopeOf(r) + "/" + name
+}
+
+// skeClusterState returns the aggregated state of a cluster that is not
+// deleted yet.
+func skeClusterState(r *skeClusterRecord, now time.Time) string {
+	switch {
+	case r.deleting():
+		return cloud.ManagedClusterStateDeleting
+	case r.ready(now):
+		return cloud.ManagedClusterStateHealthy
+	case r.updated:
+		return cloud.ManagedClusterStateReconciling
+	default:
+		return cloud.ManagedClusterStateCreating
+	}
+}
+
+func validateSKECluster(w http.ResponseWriter, name string, c *stackit.SKEClusterModel) bool {
+	if name == "" || len(name) > maxSKEClusterNameLength {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("cluster name must have 1 to %d characters",
+			maxSKEClusterNameLength))
+		return false
+	}
+	if c.Kubernetes.Version == "" {
+		writeError(w, http.StatusBadRequest, "kubernetes version is required")
+		return false
+	}
+	if len(c.Nodepools) == 0 {
+		writeError(w, http.StatusBadRequest, "at least one node pool is required")
+		return false
+	}
+	names := map[string]bool{}
+	for _, p := range c.Nodepools {
+		if p.Name == "" || len(p.Name) > maxSKENodepoolNameLength {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("node pool name must have 1 to %d characters",
+				maxSKENodepoolNameLength))
+			return false
+		}
+		if names[p.Name] {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("duplicate node pool %q", p.Name))
+			return false
+		}
+		names[p.Name] = true
+		if p.Minimum > p.Maximum {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("node pool %q: minimum exceeds maximum", p.Name))
+			return false
+		}
+		if len(p.AvailabilityZones) == 0 {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("node pool %q: availability zones are required", p.Name))
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) createOrUpdateSKECluster(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	var in stackit.SKEClusterModel
+	if !decode(w, r, &in) {
+		return
+	}
+	if !validateSKECluster(w, name, &in) {
+		return
+	}
+	in.Name = name
+	in.Status = nil
+	rec, ok := find(s.skeClusters, skeClusterKey(r, name), r)
+	switch {
+	case !ok:
+		rec = &skeClusterRecord{lifecycle: s.newLifecycle(r)}
+		s.skeClusters[skeClusterKey(r, name)] = rec
+	case rec.deleting():
+		writeError(w, http.StatusConflict, fmt.Sprintf("cluster %s is being deleted", name))
+		return
+	default:
+		rec.readyAt = s.now().Add(s.opts.ProvisioningDelay)
+		rec.updated = true
+	}
+	rec.model = in
+	s.settle()
+	writeJSON(w, http.StatusOK, s.renderSKECluster(rec))
+}
+
+func (s *Server) getSKECluster(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.skeClusters, skeClusterKey(r, r.PathValue("name")), r)
+	if !ok {
+		notFound(w, "cluster", r.PathValue("name"))
+		return
+	}
+	writeJSON(w, http.StatusOK, s.renderSKECluster(rec))
+}
+
+func (s *Server) deleteSKECluster(w http.ResponseWriter, r *http.Request) {
+	rec, ok := find(s.skeClusters, skeClusterKey(r, r.PathValue("name")), r)
+	if !ok {
+		notFound(w, "cluster", r.PathValue("name"))
+		return
+	}
+	s.markDeleted(&rec.lifecycle)
+	s.settle()
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) createSKEKubeconfig(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	rec, ok := find(s.skeClusters, skeClusterKey(r, name), r)
+	if !ok {
+		notFound(w, "cluster", name)
+		return
+	}
+	if rec.deleting() || !rec.ready(s.now()) && !rec.updated {
+		writeError(w, http.StatusConflict, fmt.Sprintf("cluster %s is not ready", name))
+		return
+	}
+	var in stackit.SKECreateKubeconfigModel
+	if !decode(w, r, &in) {
+		return
+	}
+	expiration := time.Hour
+	if in.ExpirationSeconds != "" {
+		seconds, err := strconv.Atoi(in.ExpirationSeconds)
+		if err != nil || seconds <= 0 {
+			writeError(w, http.StatusBadRequest, "expirationSeconds must be a positive number")
+			return
+		}
+		expiration = time.Duration(seconds) * time.Second
+	}
+	expiresAt := s.now().Add(expiration).UTC().Truncate(time.Second)
+	writeJSON(w, http.StatusOK, stackit.SKEKubeconfigModel{
+		Kubeconfig:          fmt.Sprintf(skeKubeconfig, name, r.PathValue("project")),
+		ExpirationTimestamp: &expiresAt,
+	})
+}
+
+func (s *Server) renderSKECluster(r *skeClusterRecord) stackit.SKEClusterModel {
+	out := r.model
+	out.Status = &stackit.SKEClusterStatusModel{Aggregated: skeClusterState(r, s.now())}
+	return out
+}
+
+// skeKubeconfig is the kubeconfig returned for a cluster, with the name of
+// the cluster and the project ID as placeholders.
+const skeKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: %[1]s
+  cluster:
+    server: https://api.%[1]s.%[2]s.ske.example.com
+contexts:
+- name: %[1]s
+  context:
+    cluster: %[1]s
+    user: %[1]s
+current-context: %[1]s
+users:
+- name: %[1]s
+  user:
+    token: fake
+`